@@ -1,3 +1,257 @@
 package chartmetric
 
-// TODO
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type getAlbumResponse struct {
+	Obj Album `json:"obj"`
+}
+
+type Album struct {
+	ID                    int           `json:"id"`
+	Name                  string        `json:"name"`
+	ImageURL              string        `json:"image_url"`
+	UPC                   string        `json:"upc"`
+	Label                 string        `json:"label"`
+	ReleaseDate           Date          `json:"release_date"`
+	TotalTracks           int           `json:"num_tracks"`
+	Explicit              bool          `json:"explicit"`
+	Description           string        `json:"description"`
+	Genres                []Genre       `json:"genres"`
+	Artists               []AlbumArtist `json:"artists"`
+	ChartmetricAlbumScore float64       `json:"cm_album_score"`
+	SpotifyAlbumIDs       []string      `json:"spotify_album_ids"`
+	ITunesAlbumIDs        []string      `json:"itunes_album_ids"`
+	DeezerAlbumIDs        []string      `json:"deezer_album_ids"`
+	AmazonAlbumIDs        []string      `json:"amazon_album_ids"`
+	SpotifyPopularity     int           `json:"spotify_popularity"`
+	ChartmetricArtistIDs  []int         `json:"cm_artist"`
+	ChartmetricTrackIDs   []int         `json:"cm_tracks"`
+}
+
+type AlbumArtist struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetAlbum fetches the metadata of an album by its Chartmetric ID.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumMetadata.
+func (c *Client) GetAlbum(ctx context.Context, id int) (*Album, error) {
+	path := fmt.Sprintf("/album/%d", id)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getAlbumResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return &response.Obj, nil
+}
+
+// ==================================================
+
+type getAlbumTracksResponse struct {
+	Obj []TrackSummary `json:"obj"`
+}
+
+// GetAlbumTracks fetches the tracks of an album.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumTracks.
+func (c *Client) GetAlbumTracks(ctx context.Context, id int) ([]TrackSummary, error) {
+	path := fmt.Sprintf("/album/%d/tracks", id)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getAlbumTracksResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// ==================================================
+
+type AlbumPlatform string
+
+const (
+	AlbumPlatformAmazon      AlbumPlatform = "amazon"
+	AlbumPlatformDeezer      AlbumPlatform = "deezer"
+	AlbumPlatformITunes      AlbumPlatform = "itunes"
+	AlbumPlatformSpotify     AlbumPlatform = "spotify"
+	AlbumPlatformUPC         AlbumPlatform = "upc"
+	AlbumPlatformChartmetric AlbumPlatform = "chartmetric"
+)
+
+type getAlbumIDsResponse struct {
+	Obj []AlbumIDs `json:"obj"`
+}
+
+type AlbumIDs struct {
+	UPC            string   `json:"upc"`
+	ChartmetricIDs []int    `json:"chartmetric_ids"`
+	SpotifyIDs     []string `json:"spotify_ids"`
+	ITunesIDs      []string `json:"itunes_ids"`
+	DeezerIDs      []string `json:"deezer_ids"`
+	AmazonIDs      []string `json:"amazon_ids"`
+}
+
+// GetAlbumIDs accepts a platform and an album's ID on that platform, then returns
+// the album IDs across different platforms for that same album.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumIDs.
+func (c *Client) GetAlbumIDs(ctx context.Context, platform AlbumPlatform, id string) (*AlbumIDs, error) {
+	path := fmt.Sprintf("/album/%s/%s/get-ids", platform, url.PathEscape(id))
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getAlbumIDsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	if len(response.Obj) == 0 {
+		return nil, fmt.Errorf("no album IDs found for platform %s and ID %s", platform, id)
+	}
+
+	return &response.Obj[0], nil
+}
+
+// ==================================================
+
+type AlbumStatSource string
+
+const (
+	AlbumStatSourceDeezer  AlbumStatSource = "deezer"
+	AlbumStatSourceSpotify AlbumStatSource = "spotify"
+)
+
+type AlbumStatMetric string
+
+const (
+	AlbumStatMetricFans       AlbumStatMetric = "fans"
+	AlbumStatMetricPopularity AlbumStatMetric = "popularity"
+	AlbumStatMetricStreams    AlbumStatMetric = "streams"
+)
+
+type GetAlbumStatsParams struct {
+	Since  Optional[time.Time]
+	Until  Optional[time.Time]
+	Latest Optional[bool]
+}
+
+type getAlbumStatsResponse struct {
+	Obj map[AlbumStatMetric]json.RawMessage `json:"obj"`
+}
+
+// AlbumStats is a set of time series, keyed by metric.
+type AlbumStats map[AlbumStatMetric][]StatPoint
+
+// Latest returns the most recent data point of a metric, if there is any.
+func (s AlbumStats) Latest(metric AlbumStatMetric) (StatPoint, bool) {
+	return latestStatPoint(s[metric])
+}
+
+// GetAlbumStats fetches the metrics time series of an album on a particular source.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumStats.
+func (c *Client) GetAlbumStats(ctx context.Context, id int, source AlbumStatSource, params *GetAlbumStatsParams) (AlbumStats, error) {
+	path := fmt.Sprintf("/album/%d/%s/stats", id, source)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Latest != nil {
+			queryParams["latest"] = *params.Latest
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getAlbumStatsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	stats, err := decodeStatSeries(response.Obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ==================================================
+
+type AlbumChartType string
+
+const (
+	AlbumChartTypeAmazon     AlbumChartType = "amazon"
+	AlbumChartTypeAppleMusic AlbumChartType = "applemusic"
+	AlbumChartTypeITunes     AlbumChartType = "itunes"
+)
+
+type GetAlbumChartsParams struct {
+	Since time.Time
+	Until Optional[time.Time]
+}
+
+// GetAlbumCharts fetches the appearances of an album on a particular chart.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumCharts.
+func (c *Client) GetAlbumCharts(ctx context.Context, id int, chartType AlbumChartType, params GetAlbumChartsParams) ([]ChartHistoryEntry, error) {
+	path := fmt.Sprintf("/album/%d/%s/charts", id, chartType)
+
+	entries, err := c.getChartHistory(ctx, path, params.Since, params.Until)
+	if err != nil {
+		return nil, fmt.Errorf("get chart history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ==================================================
+
+// GetAlbumPlaylists fetches a page of the current or past playlist placements of an album's tracks on a particular platform.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumPlaylists.
+func (c *Client) GetAlbumPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error) {
+	path := fmt.Sprintf("/album/%d/%s/%s/playlists", id, platform, status)
+
+	placements, err := c.getPlaylistPlacements(ctx, path, params)
+	if err != nil {
+		return nil, fmt.Errorf("get playlist placements: %w", err)
+	}
+
+	return placements, nil
+}
+
+// ListAlbumPlaylists returns an iterator over all the current or past playlist placements of an album's tracks
+// on a particular platform, fetching them page by page. params.Limit is used as the page size.
+// See https://api.chartmetric.com/apidoc/#api-Album-GetAlbumPlaylists.
+func (c *Client) ListAlbumPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) iter.Seq2[PlaylistPlacement, error] {
+	path := fmt.Sprintf("/album/%d/%s/%s/playlists", id, platform, status)
+
+	return c.listPlaylistPlacements(ctx, path, params)
+}
@@ -0,0 +1,195 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetAlbum(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	album, err := client.GetAlbum(context.Background(), 9128731)
+	require.NoError(t, err)
+	assert.Equal(t, "Versions of Me", album.Name)
+	assert.Equal(t, "093624871011", album.UPC)
+	assert.Equal(t, "Warner Records", album.Label)
+	assert.Equal(t, "2022-04-12", album.ReleaseDate.Format(chartmetric.DateFormat))
+	assert.Equal(t, 15, album.TotalTracks)
+	assert.True(t, album.Explicit)
+	require.Len(t, album.Artists, 1)
+	assert.Equal(t, chartmetric.AlbumArtist{ID: 3380, Name: "Anitta"}, album.Artists[0])
+	assert.Equal(t, []string{"7rnGHvfNyTMqUJt6k8ZBCb"}, album.SpotifyAlbumIDs)
+}
+
+func Test_Client_GetAlbumTracks(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731/tracks": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumTracksResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	tracks, err := client.GetAlbumTracks(context.Background(), 9128731)
+	require.NoError(t, err)
+	require.Len(t, tracks, 2)
+	assert.Equal(t, "Envolver", tracks[0].Name)
+	assert.Equal(t, "USWB12200123", tracks[1].ISRC)
+	assert.Equal(t, []int{9128731}, tracks[1].ChartmetricAlbumIDs)
+}
+
+func Test_Client_GetAlbumIDs(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/upc/093624871011/get-ids": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumIDsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	albumIDs, err := client.GetAlbumIDs(context.Background(), chartmetric.AlbumPlatformUPC, "093624871011")
+	require.NoError(t, err)
+	assert.Equal(t, []int{9128731}, albumIDs.ChartmetricIDs)
+	assert.Equal(t, []string{"7rnGHvfNyTMqUJt6k8ZBCb"}, albumIDs.SpotifyIDs)
+	assert.Equal(t, []string{"B09WJJ8M8D"}, albumIDs.AmazonIDs)
+}
+
+func Test_Client_GetAlbumStats(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731/spotify/stats": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2022-04-12", r.URL.Query().Get("since"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumStatsSpotifyResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	stats, err := client.GetAlbumStats(context.Background(), 9128731, chartmetric.AlbumStatSourceSpotify, &chartmetric.GetAlbumStatsParams{
+		Since: chartmetric.Opt(time.Date(2022, 4, 12, 0, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Len(t, stats[chartmetric.AlbumStatMetricPopularity], 2)
+
+	latest, ok := stats.Latest(chartmetric.AlbumStatMetricPopularity)
+	require.True(t, ok)
+	assert.Equal(t, 64.0, latest.Value)
+	assert.Equal(t, 3.0, latest.Diff)
+
+	streams, ok := stats.Latest(chartmetric.AlbumStatMetricStreams)
+	require.True(t, ok)
+	assert.Equal(t, 5120334.0, streams.Value)
+}
+
+func Test_Client_GetAlbumStats_MalformedSeries(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731/spotify/stats": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumStatsMalformedResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	stats, err := client.GetAlbumStats(context.Background(), 9128731, chartmetric.AlbumStatSourceSpotify, nil)
+	assert.ErrorContains(t, err, "json unmarshal")
+	assert.Nil(t, stats)
+}
+
+func Test_Client_GetAlbumCharts(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731/applemusic/charts": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2022-04-12", r.URL.Query().Get("since"))
+			assert.Equal(t, "2022-05-12", r.URL.Query().Get("until"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.AlbumChartsAppleMusicResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	entries, err := client.GetAlbumCharts(context.Background(), 9128731, chartmetric.AlbumChartTypeAppleMusic, chartmetric.GetAlbumChartsParams{
+		Since: time.Date(2022, 4, 12, 0, 0, 0, 0, time.UTC),
+		Until: chartmetric.Opt(time.Date(2022, 5, 12, 0, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Apple Music Top Albums", entries[0].ChartName)
+	assert.Equal(t, 2, entries[0].Rank)
+	assert.Equal(t, 1, entries[0].PeakRank)
+}
+
+func Test_Client_ListAlbumPlaylists(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /album/9128731/spotify/past/playlists": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			assert.Equal(t, "true", r.URL.Query().Get("editorial"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			switch r.URL.Query().Get("offset") {
+			case "0":
+				w.Write([]byte(testdata.AlbumPlaylistsSpotifyPastPage1Response))
+			case "2":
+				w.Write([]byte(testdata.AlbumPlaylistsSpotifyPastPage2Response))
+			default:
+				t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+			}
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	var placements []chartmetric.PlaylistPlacement
+	for placement, err := range client.ListAlbumPlaylists(context.Background(), 9128731, chartmetric.PlaylistPlatformSpotify, chartmetric.PlaylistStatusPast, &chartmetric.GetPlaylistPlacementsParams{
+		Limit:     chartmetric.Opt(2),
+		Editorial: chartmetric.Opt(true),
+	}) {
+		require.NoError(t, err)
+		placements = append(placements, placement)
+	}
+	require.Len(t, placements, 3)
+	assert.Equal(t, "Funk Hits", placements[1].Playlist.Name)
+	assert.Equal(t, 28, placements[1].Playlist.Period)
+	assert.Equal(t, "Latin Pop Rising", placements[2].Playlist.Name)
+	assert.Equal(t, "USWB12200123", placements[2].Track.ISRC)
+}
@@ -0,0 +1,83 @@
+package chartmetric
+
+import (
+	"context"
+	"iter"
+)
+
+// ChartsAPI is the set of chart methods provided by the Client.
+type ChartsAPI interface {
+	GetChartCountries(ctx context.Context, platform ChartPlatform, params *GetChartCountriesParams) ([]string, error)
+	GetChartTracksSpotify(ctx context.Context, params GetChartTracksSpotifyParams) ([]ChartTrackSpotify, error)
+	GetChartArtistsSpotify(ctx context.Context, params GetChartArtistSpotifyParams) ([]ChartArtistSpotify, error)
+	GetChartEntriesTikTok(ctx context.Context, params GetChartEntriesTikTokParams) ([]ChartEntryTikTok, error)
+	GetChartEntriesAppleMusic(ctx context.Context, params GetChartEntriesAppleMusicParams) ([]ChartEntryAppleMusic, error)
+	GetChartEntriesAirplay(ctx context.Context, params GetChartEntriesAirplayParams) ([]ChartEntryAirplay, error)
+}
+
+// ArtistsAPI is the set of artist methods provided by the Client.
+type ArtistsAPI interface {
+	GetArtist(ctx context.Context, id int) (*Artist, error)
+	GetArtistIDs(ctx context.Context, platform ArtistPlatform, id string) (*ArtistIDs, error)
+	GetArtistStats(ctx context.Context, id int, source ArtistStatSource, params *GetArtistStatsParams) (ArtistStats, error)
+	GetArtistCharts(ctx context.Context, id int, chartType ArtistChartType, params GetArtistChartsParams) ([]ChartHistoryEntry, error)
+	GetArtistPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error)
+	GetArtistWhereListen(ctx context.Context, id int, params *GetArtistWhereListenParams) (*ListenerGeography, error)
+	GetArtistAudienceGeography(ctx context.Context, id int, platform AudiencePlatform, params *GetArtistAudienceParams) (*ListenerGeography, error)
+	GetArtistAudienceStats(ctx context.Context, id int, platform AudiencePlatform, params *GetArtistAudienceParams) (*AudienceStats, error)
+	GetRelatedArtists(ctx context.Context, id int, params *GetRelatedArtistsParams) ([]RelatedArtist, error)
+	CrawlRelatedArtists(ctx context.Context, seeds []int, params CrawlRelatedArtistsParams) (*ArtistGraph, error)
+	ListArtistAlbums(ctx context.Context, id int, params *ListArtistAlbumsParams) iter.Seq2[AlbumSummary, error]
+	ListArtistTracks(ctx context.Context, id int, params *ListArtistTracksParams) iter.Seq2[TrackSummary, error]
+	GetArtistInsights(ctx context.Context, id int, params *GetArtistInsightsParams) ([]ArtistInsight, error)
+	GetArtistCareer(ctx context.Context, id int, params *GetArtistCareerParams) ([]ArtistCareerPoint, error)
+	GetArtistTimeline(ctx context.Context, id int, params GetArtistTimelineParams) (ArtistTimeline, error)
+	FilterArtists(ctx context.Context, filter *ArtistFilter) iter.Seq2[FilteredArtist, error]
+	GetArtistEvents(ctx context.Context, id int, status ArtistEventStatus) ([]ArtistEvent, error)
+	GetArtistCMScore(ctx context.Context, id int, params *GetArtistCMScoreParams) ([]StatPoint, error)
+	GetArtistCMRank(ctx context.Context, id int, params GetArtistCMRankParams) ([]ArtistRankPoint, error)
+	GetArtistURLs(ctx context.Context, id int) (ArtistURLs, error)
+	ResolveArtistProfileURL(ctx context.Context, rawURL string) (*ArtistIDs, error)
+}
+
+// AlbumsAPI is the set of album methods provided by the Client.
+type AlbumsAPI interface {
+	GetAlbum(ctx context.Context, id int) (*Album, error)
+	GetAlbumTracks(ctx context.Context, id int) ([]TrackSummary, error)
+	GetAlbumIDs(ctx context.Context, platform AlbumPlatform, id string) (*AlbumIDs, error)
+	GetAlbumStats(ctx context.Context, id int, source AlbumStatSource, params *GetAlbumStatsParams) (AlbumStats, error)
+	GetAlbumCharts(ctx context.Context, id int, chartType AlbumChartType, params GetAlbumChartsParams) ([]ChartHistoryEntry, error)
+	GetAlbumPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error)
+	ListAlbumPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) iter.Seq2[PlaylistPlacement, error]
+}
+
+// TracksAPI is the set of track methods provided by the Client.
+type TracksAPI interface {
+	GetTrack(ctx context.Context, id int) (*Track, error)
+	GetTrackIDs(ctx context.Context, platform TrackPlatform, id string) (*TrackIDs, error)
+	ResolveTrackIDs(ctx context.Context, platform TrackPlatform, ids []string, opts *ResolveTrackIDsOptions) []TrackIDsResult
+	GetTrackStats(ctx context.Context, id int, platform TrackStatPlatform, params *GetTrackStatsParams) ([]TrackStatSeries, error)
+	GetTrackCharts(ctx context.Context, id int, chartType TrackChartType, params GetTrackChartsParams) ([]ChartHistoryEntry, error)
+	GetTrackPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error)
+}
+
+// SearchAPI is the set of search methods provided by the Client.
+type SearchAPI interface {
+	Search(ctx context.Context, query string, params *SearchParams) (*SearchResults, error)
+	ResolveArtist(ctx context.Context, name string, hints *ResolveArtistHints) (*ArtistMatch, error)
+}
+
+// API is the full set of methods provided by the Client.
+// Downstream code can depend on API (or one of the narrower per-domain interfaces)
+// instead of *Client, and use chartmetrictest.Fake in unit tests.
+type API interface {
+	ChartsAPI
+	ArtistsAPI
+	AlbumsAPI
+	TracksAPI
+	SearchAPI
+
+	GetAny(ctx context.Context, path string, queryParams map[string]any) ([]byte, error)
+}
+
+var _ API = (*Client)(nil)
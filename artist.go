@@ -1,3 +1,1126 @@
 package chartmetric
 
-// TODO
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type ArtistCareerStage string
+
+const (
+	ArtistCareerStageUndiscovered ArtistCareerStage = "undiscovered"
+	ArtistCareerStageDeveloping   ArtistCareerStage = "developing"
+	ArtistCareerStageMidLevel     ArtistCareerStage = "mid-level"
+	ArtistCareerStageMainstream   ArtistCareerStage = "mainstream"
+	ArtistCareerStageSuperstar    ArtistCareerStage = "superstar"
+	ArtistCareerStageLegendary    ArtistCareerStage = "legendary"
+)
+
+type ArtistCareerTrend string
+
+const (
+	ArtistCareerTrendDecline         ArtistCareerTrend = "decline"
+	ArtistCareerTrendSteady          ArtistCareerTrend = "steady"
+	ArtistCareerTrendGrowth          ArtistCareerTrend = "growth"
+	ArtistCareerTrendExplosiveGrowth ArtistCareerTrend = "explosive growth"
+)
+
+type getArtistResponse struct {
+	Obj Artist `json:"obj"`
+}
+
+type Artist struct {
+	ID                     int           `json:"id"`
+	Name                   string        `json:"name"`
+	ImageURL               string        `json:"image_url"`
+	CoverURL               string        `json:"cover_url"`
+	ISNI                   string        `json:"isni"`
+	CountryCode            string        `json:"code2"`
+	HometownCity           string        `json:"hometown_city"`
+	CurrentCity            string        `json:"current_city"`
+	Description            string        `json:"description"`
+	Genres                 ArtistGenres  `json:"genres"`
+	Tags                   []string      `json:"tags"`
+	RecordLabel            string        `json:"record_label"`
+	CareerStatus           ArtistCareer  `json:"career_status"`
+	ChartmetricArtistRank  int           `json:"cm_artist_rank"`
+	ChartmetricArtistScore float64       `json:"cm_artist_score"`
+	SpotifyArtistIDs       []string      `json:"spotify_artist_ids"`
+	ITunesArtistIDs        []int         `json:"itunes_artist_ids"`
+	DeezerArtistIDs        []string      `json:"deezer_artist_ids"`
+	AmazonArtistIDs        []string      `json:"amazon_artist_ids"`
+	YouTubeChannelIDs      []string      `json:"youtube_channel_ids"`
+	TikTokIDs              []string      `json:"tiktok_ids"`
+	InstagramIDs           []string      `json:"instagram_ids"`
+	SoundCloudIDs          []string      `json:"soundcloud_ids"`
+	CreatedAt              Date          `json:"created_at"`
+	Statistics             *ArtistCounts `json:"cm_statistics"`
+}
+
+type ArtistGenres struct {
+	Primary   *Genre  `json:"primary"`
+	Secondary []Genre `json:"secondary"`
+}
+
+type Genre struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ArtistCareer struct {
+	Stage ArtistCareerStage `json:"stage"`
+	Trend ArtistCareerTrend `json:"trend"`
+}
+
+type ArtistCounts struct {
+	SpotifyFollowers        int `json:"sp_followers"`
+	SpotifyMonthlyListeners int `json:"sp_monthly_listeners"`
+	SpotifyPopularity       int `json:"sp_popularity"`
+	DeezerFans              int `json:"deezer_fans"`
+	InstagramFollowers      int `json:"ins_followers"`
+	TikTokFollowers         int `json:"tiktok_followers"`
+	YouTubeSubscribers      int `json:"ycs_subscribers"`
+}
+
+// GetArtist fetches the metadata of an artist by their Chartmetric ID.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistMetadata.
+func (c *Client) GetArtist(ctx context.Context, id int) (*Artist, error) {
+	path := fmt.Sprintf("/artist/%d", id)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return &response.Obj, nil
+}
+
+// ==================================================
+
+type ArtistPlatform string
+
+const (
+	ArtistPlatformAmazon      ArtistPlatform = "amazon"
+	ArtistPlatformDeezer      ArtistPlatform = "deezer"
+	ArtistPlatformFacebook    ArtistPlatform = "facebook"
+	ArtistPlatformITunes      ArtistPlatform = "itunes"
+	ArtistPlatformInstagram   ArtistPlatform = "instagram"
+	ArtistPlatformSoundCloud  ArtistPlatform = "soundcloud"
+	ArtistPlatformSpotify     ArtistPlatform = "spotify"
+	ArtistPlatformTikTok      ArtistPlatform = "tiktok"
+	ArtistPlatformTwitter     ArtistPlatform = "twitter"
+	ArtistPlatformYouTube     ArtistPlatform = "youtube"
+	ArtistPlatformChartmetric ArtistPlatform = "chartmetric"
+)
+
+type getArtistIDsResponse struct {
+	Obj []ArtistIDs `json:"obj"`
+}
+
+type ArtistIDs struct {
+	ChartmetricID     int      `json:"cm_artist"`
+	Name              string   `json:"artist_name"`
+	SpotifyIDs        []string `json:"spotify_artist_ids"`
+	ITunesIDs         []int    `json:"itunes_artist_ids"`
+	DeezerIDs         []string `json:"deezer_artist_ids"`
+	AmazonIDs         []string `json:"amazon_artist_ids"`
+	YouTubeChannelIDs []string `json:"youtube_channel_ids"`
+	TikTokIDs         []string `json:"tiktok_ids"`
+	InstagramIDs      []string `json:"instagram_ids"`
+	SoundCloudIDs     []string `json:"soundcloud_ids"`
+}
+
+// GetArtistIDs accepts a platform and an artist's ID on that platform, then returns
+// the artist IDs across different platforms for that same artist.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistIDs.
+func (c *Client) GetArtistIDs(ctx context.Context, platform ArtistPlatform, id string) (*ArtistIDs, error) {
+	path := fmt.Sprintf("/artist/%s/%s/get-ids", platform, url.PathEscape(id))
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistIDsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	if len(response.Obj) == 0 {
+		return nil, fmt.Errorf("no artist IDs found for platform %s and ID %s", platform, id)
+	}
+
+	return &response.Obj[0], nil
+}
+
+// ==================================================
+
+type ArtistStatSource string
+
+const (
+	ArtistStatSourceBandsintown    ArtistStatSource = "bandsintown"
+	ArtistStatSourceDeezer         ArtistStatSource = "deezer"
+	ArtistStatSourceFacebook       ArtistStatSource = "facebook"
+	ArtistStatSourceInstagram      ArtistStatSource = "instagram"
+	ArtistStatSourceLine           ArtistStatSource = "line"
+	ArtistStatSourceMelon          ArtistStatSource = "melon"
+	ArtistStatSourceSoundCloud     ArtistStatSource = "soundcloud"
+	ArtistStatSourceSpotify        ArtistStatSource = "spotify"
+	ArtistStatSourceTikTok         ArtistStatSource = "tiktok"
+	ArtistStatSourceTwitch         ArtistStatSource = "twitch"
+	ArtistStatSourceTwitter        ArtistStatSource = "twitter"
+	ArtistStatSourceWikipedia      ArtistStatSource = "wikipedia"
+	ArtistStatSourceYouTubeArtist  ArtistStatSource = "youtube_artist"
+	ArtistStatSourceYouTubeChannel ArtistStatSource = "youtube_channel"
+)
+
+type ArtistStatMetric string
+
+const (
+	ArtistStatMetricComments         ArtistStatMetric = "comments"
+	ArtistStatMetricFans             ArtistStatMetric = "fans"
+	ArtistStatMetricFollowers        ArtistStatMetric = "followers"
+	ArtistStatMetricLikes            ArtistStatMetric = "likes"
+	ArtistStatMetricListeners        ArtistStatMetric = "listeners"
+	ArtistStatMetricMonthlyListeners ArtistStatMetric = "monthly_listeners"
+	ArtistStatMetricPageViews        ArtistStatMetric = "pageviews"
+	ArtistStatMetricPlays            ArtistStatMetric = "plays"
+	ArtistStatMetricPopularity       ArtistStatMetric = "popularity"
+	ArtistStatMetricSubscribers      ArtistStatMetric = "subscribers"
+	ArtistStatMetricTalks            ArtistStatMetric = "talks"
+	ArtistStatMetricViews            ArtistStatMetric = "views"
+)
+
+type GetArtistStatsParams struct {
+	Since        Optional[time.Time]
+	Until        Optional[time.Time]
+	Field        Optional[ArtistStatMetric]
+	Latest       Optional[bool]
+	Interpolated Optional[bool]
+}
+
+type getArtistStatsResponse struct {
+	Obj map[ArtistStatMetric]json.RawMessage `json:"obj"`
+}
+
+// ArtistStats is a set of time series, keyed by metric.
+type ArtistStats map[ArtistStatMetric][]StatPoint
+
+// Latest returns the most recent data point of a metric, if there is any.
+func (s ArtistStats) Latest(metric ArtistStatMetric) (StatPoint, bool) {
+	return latestStatPoint(s[metric])
+}
+
+// GetArtistStats fetches the fan metrics time series of an artist on a particular source.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistStats.
+func (c *Client) GetArtistStats(ctx context.Context, id int, source ArtistStatSource, params *GetArtistStatsParams) (ArtistStats, error) {
+	path := fmt.Sprintf("/artist/%d/stat/%s", id, source)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Field != nil {
+			queryParams["field"] = *params.Field
+		}
+		if params.Latest != nil {
+			queryParams["latest"] = *params.Latest
+		}
+		if params.Interpolated != nil {
+			queryParams["interpolated"] = *params.Interpolated
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistStatsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	stats, err := decodeStatSeries(response.Obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ==================================================
+
+type ArtistChartType string
+
+const (
+	ArtistChartTypeAirplayDaily        ArtistChartType = "airplay_daily"
+	ArtistChartTypeAirplayWeekly       ArtistChartType = "airplay_weekly"
+	ArtistChartTypeAmazon              ArtistChartType = "amazon"
+	ArtistChartTypeAppleMusicAlbums    ArtistChartType = "applemusic_albums"
+	ArtistChartTypeAppleMusicDaily     ArtistChartType = "applemusic_daily"
+	ArtistChartTypeAppleMusicTop       ArtistChartType = "applemusic_top"
+	ArtistChartTypeAppleMusicVideos    ArtistChartType = "applemusic_videos"
+	ArtistChartTypeBeatport            ArtistChartType = "beatport"
+	ArtistChartTypeDeezer              ArtistChartType = "deezer"
+	ArtistChartTypeITunesAlbums        ArtistChartType = "itunes_albums"
+	ArtistChartTypeITunesTop           ArtistChartType = "itunes_top"
+	ArtistChartTypeITunesVideos        ArtistChartType = "itunes_videos"
+	ArtistChartTypeShazamTopDaily      ArtistChartType = "shazam_top_daily"
+	ArtistChartTypeShazamTrendingDaily ArtistChartType = "shazam_trending_daily"
+	ArtistChartTypeSoundCloud          ArtistChartType = "soundcloud"
+	ArtistChartTypeSpotifyTopDaily     ArtistChartType = "spotify_top_daily"
+	ArtistChartTypeSpotifyTopWeekly    ArtistChartType = "spotify_top_weekly"
+	ArtistChartTypeSpotifyViralDaily   ArtistChartType = "spotify_viral_daily"
+	ArtistChartTypeSpotifyViralWeekly  ArtistChartType = "spotify_viral_weekly"
+	ArtistChartTypeTikTokTopTracks     ArtistChartType = "tiktok_top_tracks"
+	ArtistChartTypeYouTubeArtists      ArtistChartType = "youtube_artists"
+	ArtistChartTypeYouTubeTracks       ArtistChartType = "youtube_tracks"
+	ArtistChartTypeYouTubeTrends       ArtistChartType = "youtube_trends"
+	ArtistChartTypeYouTubeVideos       ArtistChartType = "youtube_videos"
+)
+
+type GetArtistChartsParams struct {
+	Since time.Time
+	Until Optional[time.Time]
+}
+
+// GetArtistCharts fetches the appearances of an artist's tracks (or the artist themselves) on a particular chart.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistCharts.
+func (c *Client) GetArtistCharts(ctx context.Context, id int, chartType ArtistChartType, params GetArtistChartsParams) ([]ChartHistoryEntry, error) {
+	path := fmt.Sprintf("/artist/%d/%s/charts", id, chartType)
+
+	entries, err := c.getChartHistory(ctx, path, params.Since, params.Until)
+	if err != nil {
+		return nil, fmt.Errorf("get chart history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ==================================================
+
+// GetArtistPlaylists fetches the current or past playlist placements of an artist's tracks on a particular platform.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistPlaylists.
+func (c *Client) GetArtistPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error) {
+	path := fmt.Sprintf("/artist/%d/%s/%s/playlists", id, platform, status)
+
+	placements, err := c.getPlaylistPlacements(ctx, path, params)
+	if err != nil {
+		return nil, fmt.Errorf("get playlist placements: %w", err)
+	}
+
+	return placements, nil
+}
+
+// ==================================================
+
+// ListenerGeography is a ranking of the cities and countries where an artist's listeners (or followers) are.
+type ListenerGeography struct {
+	Date      Date
+	Cities    []ListenerCity
+	Countries []ListenerCountry
+}
+
+type ListenerCity struct {
+	Rank        int             `json:"rank"`
+	Name        string          `json:"name"`
+	CityID      int             `json:"city_id"`
+	CountryCode string          `json:"code2"`
+	Latitude    float64         `json:"lat"`
+	Longitude   float64         `json:"lng"`
+	Listeners   int             `json:"listeners"`
+	Share       float64         `json:"percent"`
+	History     []ListenerCount `json:"history"`
+}
+
+type ListenerCountry struct {
+	Rank        int             `json:"rank"`
+	Name        string          `json:"name"`
+	CountryCode string          `json:"code2"`
+	Listeners   int             `json:"listeners"`
+	Share       float64         `json:"percent"`
+	History     []ListenerCount `json:"history"`
+}
+
+type ListenerCount struct {
+	Date      Date `json:"date"`
+	Listeners int  `json:"listeners"`
+}
+
+type GetArtistWhereListenParams struct {
+	Since Optional[time.Time]
+	Until Optional[time.Time]
+	Limit Optional[int]
+}
+
+type getArtistWhereListenResponse struct {
+	Obj map[Date]struct {
+		Cities    []ListenerCity    `json:"cities"`
+		Countries []ListenerCountry `json:"countries"`
+	} `json:"obj"`
+}
+
+// GetArtistWhereListen fetches the cities and countries where an artist's Spotify listeners are,
+// ranked by the latest listener count, with the history of each over the requested dates.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetWherePeopleListen.
+func (c *Client) GetArtistWhereListen(ctx context.Context, id int, params *GetArtistWhereListenParams) (*ListenerGeography, error) {
+	path := fmt.Sprintf("/artist/%d/where-people-listen", id)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistWhereListenResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	dates := make([]Date, 0, len(response.Obj))
+	for date := range response.Obj {
+		dates = append(dates, date)
+	}
+	slices.SortFunc(dates, func(a, b Date) int {
+		return a.Compare(b.Time)
+	})
+
+	geography := &ListenerGeography{}
+	if len(dates) == 0 {
+		return geography, nil
+	}
+	geography.Date = dates[len(dates)-1]
+
+	// the latest snapshot determines the ranking, earlier ones only contribute to the history
+	latest := response.Obj[geography.Date]
+	geography.Cities = rankCities(latest.Cities)
+	geography.Countries = rankCountries(latest.Countries)
+
+	for _, date := range dates {
+		snapshot := response.Obj[date]
+		for _, city := range snapshot.Cities {
+			if i := slices.IndexFunc(geography.Cities, func(ranked ListenerCity) bool {
+				return ranked.Name == city.Name && ranked.CountryCode == city.CountryCode
+			}); i >= 0 {
+				geography.Cities[i].History = append(geography.Cities[i].History, ListenerCount{Date: date, Listeners: city.Listeners})
+			}
+		}
+		for _, country := range snapshot.Countries {
+			if i := slices.IndexFunc(geography.Countries, func(ranked ListenerCountry) bool { return ranked.CountryCode == country.CountryCode }); i >= 0 {
+				geography.Countries[i].History = append(geography.Countries[i].History, ListenerCount{Date: date, Listeners: country.Listeners})
+			}
+		}
+	}
+
+	return geography, nil
+}
+
+// ==================================================
+
+type AudiencePlatform string
+
+const (
+	AudiencePlatformInstagram AudiencePlatform = "instagram"
+	AudiencePlatformTikTok    AudiencePlatform = "tiktok"
+	AudiencePlatformYouTube   AudiencePlatform = "youtube"
+)
+
+type GetArtistAudienceParams struct {
+	Date Optional[time.Time]
+}
+
+type getArtistAudienceStatsResponse struct {
+	Obj AudienceStats `json:"obj"`
+}
+
+// AudienceStats are the demographics of an artist's audience on a social platform.
+type AudienceStats struct {
+	Timestamp               Date               `json:"timestp"`
+	Followers               int                `json:"followers"`
+	EngagementRate          float64            `json:"engagement_rate"`
+	AudienceGenders         []AudienceShare    `json:"audience_genders"`
+	AudienceGendersPerAge   []AudienceAgeGroup `json:"audience_genders_per_age"`
+	TopCountries            []AudienceCountry  `json:"top_countries"`
+	TopCities               []AudienceCity     `json:"top_cities"`
+	AudienceLanguages       []AudienceShare    `json:"audience_languages"`
+	AudienceInterests       []AudienceShare    `json:"audience_interests"`
+	AudienceBrandAffinities []AudienceShare    `json:"audience_brand_affinity"`
+	NotableFollowers        []AudienceFollower `json:"notable_followers"`
+}
+
+// AudienceShare is the weight (between 0 and 1) of a gender, language, interest or brand within an audience.
+type AudienceShare struct {
+	Code   string  `json:"code"`
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+}
+
+type AudienceAgeGroup struct {
+	Code   string  `json:"code"`
+	Male   float64 `json:"male"`
+	Female float64 `json:"female"`
+}
+
+type AudienceCountry struct {
+	Name        string  `json:"name"`
+	CountryCode string  `json:"code2"`
+	Followers   int     `json:"followers"`
+	Percent     float64 `json:"percent"`
+}
+
+type AudienceCity struct {
+	Name        string  `json:"name"`
+	CityID      int     `json:"city_id"`
+	CountryCode string  `json:"code2"`
+	Latitude    float64 `json:"lat"`
+	Longitude   float64 `json:"lng"`
+	Followers   int     `json:"followers"`
+	Percent     float64 `json:"percent"`
+}
+
+type AudienceFollower struct {
+	Username    string  `json:"username"`
+	FullName    string  `json:"fullname"`
+	PictureURL  string  `json:"picture"`
+	Followers   int     `json:"followers"`
+	Engagements float64 `json:"engagements"`
+	IsVerified  bool    `json:"is_verified"`
+}
+
+// GetArtistAudienceStats fetches the demographics of an artist's audience on Instagram, TikTok or YouTube.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistAudienceStats.
+func (c *Client) GetArtistAudienceStats(ctx context.Context, id int, platform AudiencePlatform, params *GetArtistAudienceParams) (*AudienceStats, error) {
+	path := fmt.Sprintf("/artist/%d/%s-audience-stats", id, platform)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Date != nil {
+			queryParams["date"] = (*params.Date).Format(DateFormat)
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistAudienceStatsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return &response.Obj, nil
+}
+
+// GetArtistAudienceGeography fetches the cities and countries where an artist's audience on a social platform is,
+// ranked by follower count. It is a view over GetArtistAudienceStats.
+func (c *Client) GetArtistAudienceGeography(ctx context.Context, id int, platform AudiencePlatform, params *GetArtistAudienceParams) (*ListenerGeography, error) {
+	stats, err := c.GetArtistAudienceStats(ctx, id, platform, params)
+	if err != nil {
+		return nil, fmt.Errorf("get artist audience stats: %w", err)
+	}
+
+	geography := &ListenerGeography{Date: stats.Timestamp}
+	for _, country := range stats.TopCountries {
+		geography.Countries = append(geography.Countries, ListenerCountry{
+			Name:        country.Name,
+			CountryCode: country.CountryCode,
+			Listeners:   country.Followers,
+			Share:       country.Percent,
+		})
+	}
+	for _, city := range stats.TopCities {
+		geography.Cities = append(geography.Cities, ListenerCity{
+			Name:        city.Name,
+			CityID:      city.CityID,
+			CountryCode: city.CountryCode,
+			Latitude:    city.Latitude,
+			Longitude:   city.Longitude,
+			Listeners:   city.Followers,
+			Share:       city.Percent,
+		})
+	}
+	geography.Cities = rankCities(geography.Cities)
+	geography.Countries = rankCountries(geography.Countries)
+
+	return geography, nil
+}
+
+// rankCities sorts cities by descending listener count and assigns their ranks.
+func rankCities(cities []ListenerCity) []ListenerCity {
+	ranked := slices.Clone(cities)
+	slices.SortStableFunc(ranked, func(a, b ListenerCity) int {
+		return cmp.Compare(b.Listeners, a.Listeners)
+	})
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+
+	return ranked
+}
+
+// rankCountries sorts countries by descending listener count and assigns their ranks.
+func rankCountries(countries []ListenerCountry) []ListenerCountry {
+	ranked := slices.Clone(countries)
+	slices.SortStableFunc(ranked, func(a, b ListenerCountry) int {
+		return cmp.Compare(b.Listeners, a.Listeners)
+	})
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+
+	return ranked
+}
+
+// ==================================================
+
+type GetRelatedArtistsParams struct {
+	Limit Optional[int]
+	Since Optional[time.Time]
+	Until Optional[time.Time]
+}
+
+type getRelatedArtistsResponse struct {
+	Obj []RelatedArtist `json:"obj"`
+}
+
+type RelatedArtist struct {
+	ID                      int      `json:"id"`
+	Name                    string   `json:"name"`
+	ImageURL                string   `json:"image_url"`
+	CountryCode             string   `json:"code2"`
+	Genres                  []string `json:"genres"`
+	SpotifyFollowers        int      `json:"sp_followers"`
+	SpotifyMonthlyListeners int      `json:"sp_monthly_listeners"`
+	SpotifyPopularity       int      `json:"sp_popularity"`
+	ChartmetricArtistRank   int      `json:"cm_artist_rank"`
+	ChartmetricArtistScore  float64  `json:"cm_artist_score"`
+}
+
+// GetRelatedArtists fetches the artists related to an artist.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetRelatedArtists.
+func (c *Client) GetRelatedArtists(ctx context.Context, id int, params *GetRelatedArtistsParams) ([]RelatedArtist, error) {
+	path := fmt.Sprintf("/artist/%d/relatedartists", id)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getRelatedArtistsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// ==================================================
+
+type ListArtistAlbumsParams struct {
+	// PageSize is the number of albums fetched per request. Defaults to 100.
+	PageSize  Optional[int]
+	IsPrimary Optional[bool]
+}
+
+type listArtistAlbumsResponse struct {
+	Obj []AlbumSummary `json:"obj"`
+}
+
+// AlbumSummary is the summary of an album, as listed in an artist's catalog.
+type AlbumSummary struct {
+	ID                   int      `json:"cm_album"`
+	Name                 string   `json:"name"`
+	ImageURL             string   `json:"image_url"`
+	UPC                  string   `json:"upc"`
+	Label                string   `json:"label"`
+	ReleaseDate          Date     `json:"release_date"`
+	NumTracks            int      `json:"num_tracks"`
+	ChartmetricArtistIDs []int    `json:"cm_artist"`
+	ArtistNames          []string `json:"artist_names"`
+}
+
+// ListArtistAlbums returns an iterator over all the albums of an artist, fetching them page by page.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistAlbums.
+func (c *Client) ListArtistAlbums(ctx context.Context, id int, params *ListArtistAlbumsParams) iter.Seq2[AlbumSummary, error] {
+	path := fmt.Sprintf("/artist/%d/albums", id)
+	if params == nil {
+		params = &ListArtistAlbumsParams{}
+	}
+
+	return paginate(ctx, pageSizeOrDefault(params.PageSize), func(ctx context.Context, offset, limit int) ([]AlbumSummary, error) {
+		queryParams := make(map[string]any)
+		queryParams["offset"] = offset
+		queryParams["limit"] = limit
+		if params.IsPrimary != nil {
+			queryParams["isPrimary"] = *params.IsPrimary
+		}
+
+		responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+		if err != nil {
+			return nil, fmt.Errorf("request with retry: %w", err)
+		}
+
+		var response listArtistAlbumsResponse
+		if err := json.Unmarshal(responseData, &response); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+
+		return response.Obj, nil
+	})
+}
+
+type ListArtistTracksParams struct {
+	// PageSize is the number of tracks fetched per request. Defaults to 100.
+	PageSize  Optional[int]
+	IsPrimary Optional[bool]
+	// ExpandIDs fetches the cross-platform IDs of every track with GetTrackIDs, which costs one request per track.
+	ExpandIDs bool
+}
+
+type listArtistTracksResponse struct {
+	Obj []TrackSummary `json:"obj"`
+}
+
+// TrackSummary is the summary of a track, as listed in an artist's catalog.
+type TrackSummary struct {
+	ID                   int      `json:"cm_track"`
+	Name                 string   `json:"name"`
+	ISRC                 string   `json:"isrc"`
+	ImageURL             string   `json:"image_url"`
+	Label                string   `json:"label"`
+	ReleaseDate          Date     `json:"release_date"`
+	ChartmetricAlbumIDs  []int    `json:"album_ids"`
+	AlbumNames           []string `json:"album_names"`
+	AlbumUPC             []string `json:"album_upc"`
+	ChartmetricArtistIDs []int    `json:"cm_artist"`
+	ArtistNames          []string `json:"artist_names"`
+	// IDs holds the cross-platform IDs of the track, when expanded (see ListArtistTracksParams.ExpandIDs).
+	IDs *TrackIDs `json:"-"`
+}
+
+// ListArtistTracks returns an iterator over all the tracks of an artist, fetching them page by page.
+// If the cross-platform IDs of a track cannot be expanded, the track is yielded along with the error
+// and the iteration can carry on.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistTracks.
+func (c *Client) ListArtistTracks(ctx context.Context, id int, params *ListArtistTracksParams) iter.Seq2[TrackSummary, error] {
+	path := fmt.Sprintf("/artist/%d/tracks", id)
+	if params == nil {
+		params = &ListArtistTracksParams{}
+	}
+
+	tracks := paginate(ctx, pageSizeOrDefault(params.PageSize), func(ctx context.Context, offset, limit int) ([]TrackSummary, error) {
+		queryParams := make(map[string]any)
+		queryParams["offset"] = offset
+		queryParams["limit"] = limit
+		if params.IsPrimary != nil {
+			queryParams["isPrimary"] = *params.IsPrimary
+		}
+
+		responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+		if err != nil {
+			return nil, fmt.Errorf("request with retry: %w", err)
+		}
+
+		var response listArtistTracksResponse
+		if err := json.Unmarshal(responseData, &response); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+
+		return response.Obj, nil
+	})
+	if !params.ExpandIDs {
+		return tracks
+	}
+
+	return func(yield func(TrackSummary, error) bool) {
+		for track, err := range tracks {
+			if err == nil {
+				track.IDs, err = c.GetTrackIDs(ctx, TrackPlatformChartmetric, strconv.Itoa(track.ID))
+				if err != nil {
+					err = fmt.Errorf("get track IDs of %d: %w", track.ID, err)
+				}
+			}
+			if !yield(track, err) {
+				return
+			}
+		}
+	}
+}
+
+// ==================================================
+
+type GetArtistInsightsParams struct {
+	Limit Optional[int]
+	// Weight is the minimum importance (1 to 10) of the returned insights.
+	Weight Optional[int]
+}
+
+type getArtistInsightsResponse struct {
+	Obj []ArtistInsight `json:"obj"`
+}
+
+// ArtistInsight is a noteworthy event in an artist's career, such as a chart debut or a follower milestone.
+type ArtistInsight struct {
+	ID        int             `json:"id"`
+	Type      string          `json:"type"`
+	Platform  string          `json:"platform"`
+	Weight    int             `json:"weight"`
+	Text      string          `json:"text"`
+	Timestamp Date            `json:"timestp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// GetArtistInsights fetches the noteworthy insights of an artist.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistNoteworthyInsights.
+func (c *Client) GetArtistInsights(ctx context.Context, id int, params *GetArtistInsightsParams) ([]ArtistInsight, error) {
+	path := fmt.Sprintf("/artist/%d/noteworthy-insights", id)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+		if params.Weight != nil {
+			queryParams["weight"] = *params.Weight
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistInsightsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+type GetArtistCareerParams struct {
+	Since Optional[time.Time]
+	Until Optional[time.Time]
+	Limit Optional[int]
+}
+
+type getArtistCareerResponse struct {
+	Obj []ArtistCareerPoint `json:"obj"`
+}
+
+type ArtistCareerPoint struct {
+	Timestamp     Date              `json:"timestp"`
+	Stage         ArtistCareerStage `json:"stage"`
+	Trend         ArtistCareerTrend `json:"trend"`
+	StageScore    float64           `json:"stage_score"`
+	MomentumScore float64           `json:"momentum_score"`
+}
+
+// GetArtistCareer fetches the history of an artist's career stage and trend.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistCareer.
+func (c *Client) GetArtistCareer(ctx context.Context, id int, params *GetArtistCareerParams) ([]ArtistCareerPoint, error) {
+	path := fmt.Sprintf("/artist/%d/career", id)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistCareerResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// ==================================================
+
+type ArtistEventStatus string
+
+const (
+	ArtistEventStatusPast     ArtistEventStatus = "past"
+	ArtistEventStatusUpcoming ArtistEventStatus = "upcoming"
+)
+
+type getArtistEventsResponse struct {
+	Obj []ArtistEvent `json:"obj"`
+}
+
+type ArtistEvent struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	Date          Date    `json:"date"`
+	VenueName     string  `json:"venue_name"`
+	VenueCapacity int     `json:"venue_capacity"`
+	City          string  `json:"city"`
+	CountryCode   string  `json:"code2"`
+	Latitude      float64 `json:"lat"`
+	Longitude     float64 `json:"lng"`
+	TicketSource  string  `json:"ticket_source"`
+	TicketURL     string  `json:"ticket_url"`
+}
+
+// GetArtistEvents fetches the past or upcoming events (concerts, festivals) of an artist.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistEvents.
+func (c *Client) GetArtistEvents(ctx context.Context, id int, status ArtistEventStatus) ([]ArtistEvent, error) {
+	path := fmt.Sprintf("/artist/%d/%s/events", id, status)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistEventsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// EventMetricChange is the change of a metric around an event.
+type EventMetricChange struct {
+	Event ArtistEvent
+	// Before is the latest data point at or before the start of the window.
+	Before StatPoint
+	// After is the latest data point at or before the end of the window. It is always after the event.
+	After         StatPoint
+	Change        float64
+	ChangePercent float64
+}
+
+// EventMetricChanges joins events against a metric series (see GetArtistStats), returning the change
+// of the metric between window before and window after each event date.
+// Events without data points on both sides of the event date are left out, such as events after the
+// last data point of the series.
+func EventMetricChanges(events []ArtistEvent, series []StatPoint, window time.Duration) []EventMetricChange {
+	sorted := slices.Clone(series)
+	slices.SortFunc(sorted, func(a, b StatPoint) int {
+		return a.Timestamp.Compare(b.Timestamp.Time)
+	})
+
+	// latestAt returns the latest data point at or before t
+	latestAt := func(t time.Time) (StatPoint, bool) {
+		i, found := slices.BinarySearchFunc(sorted, t, func(point StatPoint, t time.Time) int {
+			return point.Timestamp.Compare(t)
+		})
+		if found {
+			return sorted[i], true
+		}
+		if i == 0 {
+			return StatPoint{}, false
+		}
+		return sorted[i-1], true
+	}
+
+	var changes []EventMetricChange
+	for _, event := range events {
+		before, ok := latestAt(event.Date.Add(-window))
+		if !ok {
+			continue
+		}
+		after, ok := latestAt(event.Date.Add(window))
+		if !ok || !after.Timestamp.After(event.Date.Time) {
+			continue
+		}
+
+		change := EventMetricChange{
+			Event:  event,
+			Before: before,
+			After:  after,
+			Change: after.Value - before.Value,
+		}
+		if before.Value != 0 {
+			change.ChangePercent = change.Change / before.Value * 100
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// ==================================================
+
+type GetArtistCMScoreParams struct {
+	Since Optional[time.Time]
+	Until Optional[time.Time]
+}
+
+type getArtistCMScoreResponse struct {
+	Obj []struct {
+		Timestamp Date    `json:"timestp"`
+		Score     float64 `json:"score"`
+	} `json:"obj"`
+}
+
+// GetArtistCMScore fetches the history of an artist's Chartmetric score.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistCMScore.
+func (c *Client) GetArtistCMScore(ctx context.Context, id int, params *GetArtistCMScoreParams) ([]StatPoint, error) {
+	path := fmt.Sprintf("/artist/%d/cmScore", id)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistCMScoreResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	points := make([]StatPoint, 0, len(response.Obj))
+	for _, point := range response.Obj {
+		points = append(points, StatPoint{Value: point.Score, Timestamp: point.Timestamp})
+	}
+
+	return points, nil
+}
+
+type ArtistRankScope string
+
+const (
+	ArtistRankScopeOverall ArtistRankScope = "overall"
+	ArtistRankScopeGenre   ArtistRankScope = "genre"
+	ArtistRankScopeCountry ArtistRankScope = "country"
+)
+
+type GetArtistCMRankParams struct {
+	// Scope defaults to ArtistRankScopeOverall.
+	Scope ArtistRankScope
+	// Genre is required by ArtistRankScopeGenre.
+	Genre Optional[string]
+	// CountryCode is required by ArtistRankScopeCountry.
+	CountryCode Optional[string]
+	Since       Optional[time.Time]
+	Until       Optional[time.Time]
+}
+
+type getArtistCMRankResponse struct {
+	Obj []ArtistRankPoint `json:"obj"`
+}
+
+type ArtistRankPoint struct {
+	Timestamp   Date   `json:"timestp"`
+	Rank        int    `json:"rank"`
+	Genre       string `json:"genre"`
+	CountryCode string `json:"code2"`
+}
+
+// GetArtistCMRank fetches the history of an artist's Chartmetric rank, overall or within a genre or country.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistCMRank.
+func (c *Client) GetArtistCMRank(ctx context.Context, id int, params GetArtistCMRankParams) ([]ArtistRankPoint, error) {
+	path := fmt.Sprintf("/artist/%d/cmRank", id)
+
+	scope := params.Scope
+	if scope == "" {
+		scope = ArtistRankScopeOverall
+	}
+	switch {
+	case scope == ArtistRankScopeGenre && (params.Genre == nil || *params.Genre == ""):
+		return nil, fmt.Errorf("genre is required by the %s rank scope", scope)
+	case scope == ArtistRankScopeCountry && (params.CountryCode == nil || *params.CountryCode == ""):
+		return nil, fmt.Errorf("country code is required by the %s rank scope", scope)
+	}
+
+	queryParams := make(map[string]any)
+	queryParams["type"] = scope
+	if params.Genre != nil {
+		queryParams["genre"] = *params.Genre
+	}
+	if params.CountryCode != nil {
+		queryParams["code2"] = *params.CountryCode
+	}
+	if params.Since != nil {
+		queryParams["since"] = (*params.Since).Format(DateFormat)
+	}
+	if params.Until != nil {
+		queryParams["until"] = (*params.Until).Format(DateFormat)
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistCMRankResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
@@ -0,0 +1,340 @@
+package chartmetric
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+type ArtistFilterMetric string
+
+const (
+	ArtistFilterMetricChartmetricRank         ArtistFilterMetric = "cm_artist_rank"
+	ArtistFilterMetricChartmetricScore        ArtistFilterMetric = "cm_artist_score"
+	ArtistFilterMetricDeezerFans              ArtistFilterMetric = "deezer_fans"
+	ArtistFilterMetricInstagramFollowers      ArtistFilterMetric = "ins_followers"
+	ArtistFilterMetricSpotifyFollowers        ArtistFilterMetric = "sp_followers"
+	ArtistFilterMetricSpotifyMonthlyListeners ArtistFilterMetric = "sp_monthly_listeners"
+	ArtistFilterMetricSpotifyPopularity       ArtistFilterMetric = "sp_popularity"
+	ArtistFilterMetricTikTokFollowers         ArtistFilterMetric = "tiktok_followers"
+	ArtistFilterMetricYouTubeSubscribers      ArtistFilterMetric = "ycs_subscribers"
+	ArtistFilterMetricYouTubeViews            ArtistFilterMetric = "ycs_views"
+)
+
+var knownArtistFilterMetrics = map[ArtistFilterMetric]bool{
+	ArtistFilterMetricChartmetricRank:         true,
+	ArtistFilterMetricChartmetricScore:        true,
+	ArtistFilterMetricDeezerFans:              true,
+	ArtistFilterMetricInstagramFollowers:      true,
+	ArtistFilterMetricSpotifyFollowers:        true,
+	ArtistFilterMetricSpotifyMonthlyListeners: true,
+	ArtistFilterMetricSpotifyPopularity:       true,
+	ArtistFilterMetricTikTokFollowers:         true,
+	ArtistFilterMetricYouTubeSubscribers:      true,
+	ArtistFilterMetricYouTubeViews:            true,
+}
+
+type ArtistFilterGrowthWindow string
+
+const (
+	ArtistFilterGrowthWindowWeek    ArtistFilterGrowthWindow = "7d"
+	ArtistFilterGrowthWindowMonth   ArtistFilterGrowthWindow = "30d"
+	ArtistFilterGrowthWindowQuarter ArtistFilterGrowthWindow = "90d"
+)
+
+var knownArtistFilterGrowthWindows = map[ArtistFilterGrowthWindow]bool{
+	ArtistFilterGrowthWindowWeek:    true,
+	ArtistFilterGrowthWindowMonth:   true,
+	ArtistFilterGrowthWindowQuarter: true,
+}
+
+var knownArtistCareerStages = map[ArtistCareerStage]bool{
+	ArtistCareerStageUndiscovered: true,
+	ArtistCareerStageDeveloping:   true,
+	ArtistCareerStageMidLevel:     true,
+	ArtistCareerStageMainstream:   true,
+	ArtistCareerStageSuperstar:    true,
+	ArtistCareerStageLegendary:    true,
+}
+
+// ArtistFilter is a typed builder for the params of FilterArtists.
+// Its methods can be chained, e.g.:
+//
+//	chartmetric.NewArtistFilter().
+//		Countries("BR").
+//		Genres("funk").
+//		MetricBetween(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, 50_000, 500_000).
+//		GrowthAtLeast(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, chartmetric.ArtistFilterGrowthWindowMonth, 20).
+//		SortBy(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, true)
+type ArtistFilter struct {
+	countries    []string
+	genres       []string
+	careerStages []ArtistCareerStage
+	metrics      map[ArtistFilterMetric]filterRange
+	growths      map[artistFilterGrowth]filterRange
+	sortMetric   ArtistFilterMetric
+	sortWindow   ArtistFilterGrowthWindow
+	sortDesc     bool
+	pageSize     int
+}
+
+type artistFilterGrowth struct {
+	metric ArtistFilterMetric
+	window ArtistFilterGrowthWindow
+}
+
+type filterRange struct {
+	min Optional[float64]
+	max Optional[float64]
+}
+
+// NewArtistFilter is the constructor for ArtistFilter. An empty filter matches every artist.
+// The zero value of ArtistFilter is an empty filter too.
+func NewArtistFilter() *ArtistFilter {
+	return &ArtistFilter{
+		metrics: make(map[ArtistFilterMetric]filterRange),
+		growths: make(map[artistFilterGrowth]filterRange),
+	}
+}
+
+// Countries restricts the artists to the given ISO 3166-1 alpha-2 country codes.
+func (f *ArtistFilter) Countries(countryCodes ...string) *ArtistFilter {
+	f.countries = append(f.countries, countryCodes...)
+	return f
+}
+
+// Genres restricts the artists to the given genres.
+func (f *ArtistFilter) Genres(genres ...string) *ArtistFilter {
+	f.genres = append(f.genres, genres...)
+	return f
+}
+
+// CareerStages restricts the artists to the given career stages.
+func (f *ArtistFilter) CareerStages(stages ...ArtistCareerStage) *ArtistFilter {
+	f.careerStages = append(f.careerStages, stages...)
+	return f
+}
+
+// MetricAtLeast restricts the artists to those whose metric is at least minValue.
+func (f *ArtistFilter) MetricAtLeast(metric ArtistFilterMetric, minValue float64) *ArtistFilter {
+	r := f.metrics[metric]
+	r.min = Opt(minValue)
+	f.setMetricRange(metric, r)
+	return f
+}
+
+// MetricAtMost restricts the artists to those whose metric is at most maxValue.
+func (f *ArtistFilter) MetricAtMost(metric ArtistFilterMetric, maxValue float64) *ArtistFilter {
+	r := f.metrics[metric]
+	r.max = Opt(maxValue)
+	f.setMetricRange(metric, r)
+	return f
+}
+
+// MetricBetween restricts the artists to those whose metric is between minValue and maxValue, inclusive.
+func (f *ArtistFilter) MetricBetween(metric ArtistFilterMetric, minValue, maxValue float64) *ArtistFilter {
+	f.setMetricRange(metric, filterRange{min: Opt(minValue), max: Opt(maxValue)})
+	return f
+}
+
+// GrowthAtLeast restricts the artists to those whose metric grew by at least minPercent over the window.
+func (f *ArtistFilter) GrowthAtLeast(metric ArtistFilterMetric, window ArtistFilterGrowthWindow, minPercent float64) *ArtistFilter {
+	key := artistFilterGrowth{metric: metric, window: window}
+	r := f.growths[key]
+	r.min = Opt(minPercent)
+	f.setGrowthRange(key, r)
+	return f
+}
+
+// GrowthBetween restricts the artists to those whose metric grew by between minPercent and maxPercent over the window.
+func (f *ArtistFilter) GrowthBetween(metric ArtistFilterMetric, window ArtistFilterGrowthWindow, minPercent, maxPercent float64) *ArtistFilter {
+	f.setGrowthRange(artistFilterGrowth{metric: metric, window: window}, filterRange{min: Opt(minPercent), max: Opt(maxPercent)})
+	return f
+}
+
+// setMetricRange and setGrowthRange create the maps on first use, so that the zero value of ArtistFilter can be used.
+func (f *ArtistFilter) setMetricRange(metric ArtistFilterMetric, r filterRange) {
+	if f.metrics == nil {
+		f.metrics = make(map[ArtistFilterMetric]filterRange)
+	}
+	f.metrics[metric] = r
+}
+
+func (f *ArtistFilter) setGrowthRange(growth artistFilterGrowth, r filterRange) {
+	if f.growths == nil {
+		f.growths = make(map[artistFilterGrowth]filterRange)
+	}
+	f.growths[growth] = r
+}
+
+// SortBy sorts the artists by a metric.
+func (f *ArtistFilter) SortBy(metric ArtistFilterMetric, desc bool) *ArtistFilter {
+	f.sortMetric = metric
+	f.sortWindow = ""
+	f.sortDesc = desc
+	return f
+}
+
+// SortByGrowth sorts the artists by the growth of a metric over a window.
+func (f *ArtistFilter) SortByGrowth(metric ArtistFilterMetric, window ArtistFilterGrowthWindow, desc bool) *ArtistFilter {
+	f.sortMetric = metric
+	f.sortWindow = window
+	f.sortDesc = desc
+	return f
+}
+
+// PageSize sets the number of artists fetched per request. Defaults to 100.
+func (f *ArtistFilter) PageSize(pageSize int) *ArtistFilter {
+	f.pageSize = pageSize
+	return f
+}
+
+// Validate checks that every career stage, metric and growth window of the filter (including sorting)
+// is a known constant, and that every range is well-formed.
+func (f *ArtistFilter) Validate() error {
+	var errs []error
+	for _, stage := range f.careerStages {
+		if !knownArtistCareerStages[stage] {
+			errs = append(errs, fmt.Errorf("unknown career stage %q", stage))
+		}
+	}
+	for metric, r := range f.metrics {
+		if !knownArtistFilterMetrics[metric] {
+			errs = append(errs, fmt.Errorf("unknown metric %q", metric))
+		}
+		if r.min != nil && r.max != nil && *r.min > *r.max {
+			errs = append(errs, fmt.Errorf("metric %s: min %v is greater than max %v", metric, *r.min, *r.max))
+		}
+	}
+	for growth, r := range f.growths {
+		if !knownArtistFilterMetrics[growth.metric] {
+			errs = append(errs, fmt.Errorf("unknown growth metric %q", growth.metric))
+		}
+		if !knownArtistFilterGrowthWindows[growth.window] {
+			errs = append(errs, fmt.Errorf("unknown growth window %q", growth.window))
+		}
+		if r.min != nil && r.max != nil && *r.min > *r.max {
+			errs = append(errs, fmt.Errorf("growth %s: min %v is greater than max %v", growthParam(growth.metric, growth.window), *r.min, *r.max))
+		}
+	}
+
+	if f.sortMetric != "" && !knownArtistFilterMetrics[f.sortMetric] {
+		errs = append(errs, fmt.Errorf("unknown sort metric %q", f.sortMetric))
+	}
+	if f.sortWindow != "" && !knownArtistFilterGrowthWindows[f.sortWindow] {
+		errs = append(errs, fmt.Errorf("unknown sort growth window %q", f.sortWindow))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *ArtistFilter) queryParams() map[string]any {
+	queryParams := make(map[string]any)
+	if len(f.countries) > 0 {
+		queryParams["code2"] = f.countries
+	}
+	if len(f.genres) > 0 {
+		queryParams["genres"] = f.genres
+	}
+	if len(f.careerStages) > 0 {
+		stages := make([]string, 0, len(f.careerStages))
+		for _, stage := range f.careerStages {
+			stages = append(stages, string(stage))
+		}
+		queryParams["career_stage"] = stages
+	}
+	for metric, r := range f.metrics {
+		addRangeParams(queryParams, string(metric), r)
+	}
+	for growth, r := range f.growths {
+		addRangeParams(queryParams, growthParam(growth.metric, growth.window), r)
+	}
+	if f.sortMetric != "" {
+		queryParams["sortColumn"] = string(f.sortMetric)
+		if f.sortWindow != "" {
+			queryParams["sortColumn"] = growthParam(f.sortMetric, f.sortWindow)
+		}
+		queryParams["sortOrderDesc"] = f.sortDesc
+	}
+
+	return queryParams
+}
+
+func addRangeParams(queryParams map[string]any, name string, r filterRange) {
+	if r.min != nil {
+		queryParams["min_"+name] = strconv.FormatFloat(*r.min, 'f', -1, 64)
+	}
+	if r.max != nil {
+		queryParams["max_"+name] = strconv.FormatFloat(*r.max, 'f', -1, 64)
+	}
+}
+
+func growthParam(metric ArtistFilterMetric, window ArtistFilterGrowthWindow) string {
+	return fmt.Sprintf("%s_growth_%s", metric, window)
+}
+
+type filterArtistsResponse struct {
+	Obj []FilteredArtist `json:"obj"`
+}
+
+type FilteredArtist struct {
+	ID                      int               `json:"id"`
+	Name                    string            `json:"name"`
+	ImageURL                string            `json:"image_url"`
+	CountryCode             string            `json:"code2"`
+	Genres                  []string          `json:"genres"`
+	CareerStage             ArtistCareerStage `json:"career_stage"`
+	ChartmetricArtistRank   int               `json:"cm_artist_rank"`
+	ChartmetricArtistScore  float64           `json:"cm_artist_score"`
+	SpotifyFollowers        int               `json:"sp_followers"`
+	SpotifyMonthlyListeners int               `json:"sp_monthly_listeners"`
+	SpotifyPopularity       int               `json:"sp_popularity"`
+	DeezerFans              int               `json:"deezer_fans"`
+	InstagramFollowers      int               `json:"ins_followers"`
+	TikTokFollowers         int               `json:"tiktok_followers"`
+	YouTubeSubscribers      int               `json:"ycs_subscribers"`
+	YouTubeViews            int               `json:"ycs_views"`
+}
+
+// FilterArtists returns an iterator over all the artists matching a filter, fetching them page by page.
+// If the filter is invalid (see ArtistFilter.Validate), the validation error is yielded and no request is made.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistList.
+func (c *Client) FilterArtists(ctx context.Context, filter *ArtistFilter) iter.Seq2[FilteredArtist, error] {
+	path := "/artist/list/filter"
+	if filter == nil {
+		filter = NewArtistFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return func(yield func(FilteredArtist, error) bool) {
+			yield(FilteredArtist{}, fmt.Errorf("validate filter: %w", err))
+		}
+	}
+
+	pageSize := defaultPageSize
+	if filter.pageSize > 0 {
+		pageSize = filter.pageSize
+	}
+
+	return paginate(ctx, pageSize, func(ctx context.Context, offset, limit int) ([]FilteredArtist, error) {
+		queryParams := filter.queryParams()
+		queryParams["offset"] = offset
+		queryParams["limit"] = limit
+
+		responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+		if err != nil {
+			return nil, fmt.Errorf("request with retry: %w", err)
+		}
+
+		var response filterArtistsResponse
+		if err := json.Unmarshal(responseData, &response); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+
+		return response.Obj, nil
+	})
+}
@@ -0,0 +1,83 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_FilterArtists(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/list/filter": func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			assert.Equal(t, []string{"BR"}, query["code2"])
+			assert.Equal(t, []string{"funk"}, query["genres"])
+			assert.Equal(t, "50000", query.Get("min_sp_monthly_listeners"))
+			assert.Equal(t, "500000", query.Get("max_sp_monthly_listeners"))
+			assert.Equal(t, "20", query.Get("min_sp_monthly_listeners_growth_30d"))
+			assert.Equal(t, "sp_monthly_listeners_growth_30d", query.Get("sortColumn"))
+			assert.Equal(t, "true", query.Get("sortOrderDesc"))
+			assert.Equal(t, "0", query.Get("offset"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.FilterArtistsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	filter := chartmetric.NewArtistFilter().
+		Countries("BR").
+		Genres("funk").
+		MetricBetween(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, 50_000, 500_000).
+		GrowthAtLeast(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, chartmetric.ArtistFilterGrowthWindowMonth, 20).
+		SortByGrowth(chartmetric.ArtistFilterMetricSpotifyMonthlyListeners, chartmetric.ArtistFilterGrowthWindowMonth, true)
+
+	var artists []chartmetric.FilteredArtist
+	for artist, err := range client.FilterArtists(context.Background(), filter) {
+		require.NoError(t, err)
+		artists = append(artists, artist)
+	}
+	require.Len(t, artists, 2)
+	assert.Equal(t, chartmetric.ArtistCareerStageMainstream, artists[0].CareerStage)
+	assert.Equal(t, 310000, artists[1].SpotifyMonthlyListeners)
+}
+
+func Test_ArtistFilter_Validate(t *testing.T) {
+	assert.NoError(t, chartmetric.NewArtistFilter().
+		MetricAtLeast(chartmetric.ArtistFilterMetricSpotifyFollowers, 1000).
+		Validate())
+
+	err := chartmetric.NewArtistFilter().
+		MetricAtLeast(chartmetric.ArtistFilterMetric("sp_fans"), 1000).
+		GrowthAtLeast(chartmetric.ArtistFilterMetricSpotifyFollowers, chartmetric.ArtistFilterGrowthWindow("1y"), 10).
+		MetricBetween(chartmetric.ArtistFilterMetricSpotifyPopularity, 80, 20).
+		CareerStages(chartmetric.ArtistCareerStageSuperstar, chartmetric.ArtistCareerStage("rising")).
+		Validate()
+	assert.ErrorContains(t, err, `unknown metric "sp_fans"`)
+	assert.ErrorContains(t, err, `unknown growth window "1y"`)
+	assert.ErrorContains(t, err, "min 80 is greater than max 20")
+	assert.ErrorContains(t, err, `unknown career stage "rising"`)
+	assert.NotContains(t, err.Error(), `"superstar"`)
+
+	// the zero value is usable as is
+	var filter chartmetric.ArtistFilter
+	filter.MetricAtLeast(chartmetric.ArtistFilterMetricSpotifyFollowers, 1000).
+		MetricAtMost(chartmetric.ArtistFilterMetricSpotifyPopularity, 80).
+		GrowthAtLeast(chartmetric.ArtistFilterMetricSpotifyFollowers, chartmetric.ArtistFilterGrowthWindowMonth, 10).
+		GrowthBetween(chartmetric.ArtistFilterMetricSpotifyFollowers, chartmetric.ArtistFilterGrowthWindowWeek, 5, 50)
+	assert.NoError(t, filter.Validate())
+
+	client := chartmetric.NewClient("test-refresh-token")
+	for _, err := range client.FilterArtists(context.Background(), chartmetric.NewArtistFilter().SortBy("sp_fans", true)) {
+		assert.ErrorContains(t, err, `validate filter: unknown sort metric "sp_fans"`)
+	}
+}
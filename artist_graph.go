@@ -0,0 +1,87 @@
+package chartmetric
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+type CrawlRelatedArtistsParams struct {
+	// MaxDepth is the number of hops from the seeds to explore. Seeds are at depth 0.
+	MaxDepth int
+	// MaxNodes caps the number of artists in the graph. Zero means no cap.
+	MaxNodes int
+	// Related holds the params used for every GetRelatedArtists call.
+	Related *GetRelatedArtistsParams
+}
+
+// ArtistGraph is a graph of related artists, keyed by Chartmetric artist ID.
+// It can be exported as is with encoding/json.
+type ArtistGraph struct {
+	Nodes map[int]ArtistGraphNode `json:"nodes"`
+	// Edges is the adjacency list of the graph. Every listed ID is also a node of the graph.
+	Edges map[int][]int `json:"edges"`
+}
+
+type ArtistGraphNode struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Depth int    `json:"depth"`
+}
+
+// CrawlRelatedArtists walks the related-artist graph breadth-first, starting from the seed artists,
+// up to params.MaxDepth hops and params.MaxNodes artists. Every artist is fetched at most once.
+// Requests go through the Client's rate limiter, so a crawl never exceeds the configured rate.
+// On error, the graph crawled so far is returned along with the error.
+func (c *Client) CrawlRelatedArtists(ctx context.Context, seeds []int, params CrawlRelatedArtistsParams) (*ArtistGraph, error) {
+	graph := &ArtistGraph{
+		Nodes: make(map[int]ArtistGraphNode),
+		Edges: make(map[int][]int),
+	}
+	isFull := func() bool {
+		return params.MaxNodes > 0 && len(graph.Nodes) >= params.MaxNodes
+	}
+
+	var queue []int
+	for _, seed := range seeds {
+		if _, ok := graph.Nodes[seed]; ok || isFull() {
+			continue
+		}
+		graph.Nodes[seed] = ArtistGraphNode{ID: seed}
+		queue = append(queue, seed)
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		node := graph.Nodes[id]
+		if node.Depth >= params.MaxDepth {
+			continue
+		}
+
+		relatedArtists, err := c.GetRelatedArtists(ctx, id, params.Related)
+		if err != nil {
+			return graph, fmt.Errorf("get related artists of %d: %w", id, err)
+		}
+
+		for _, related := range relatedArtists {
+			if _, ok := graph.Nodes[related.ID]; !ok {
+				if isFull() {
+					continue
+				}
+				graph.Nodes[related.ID] = ArtistGraphNode{ID: related.ID, Name: related.Name, Depth: node.Depth + 1}
+				queue = append(queue, related.ID)
+			} else if existing := graph.Nodes[related.ID]; existing.Name == "" {
+				existing.Name = related.Name
+				graph.Nodes[related.ID] = existing
+			}
+
+			if !slices.Contains(graph.Edges[id], related.ID) {
+				graph.Edges[id] = append(graph.Edges[id], related.ID)
+			}
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,76 @@
+package chartmetric_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetRelatedArtists(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/relatedartists": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.RelatedArtistsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	relatedArtists, err := client.GetRelatedArtists(context.Background(), 3380, &chartmetric.GetRelatedArtistsParams{Limit: chartmetric.Opt(2)})
+	require.NoError(t, err)
+	require.Len(t, relatedArtists, 2)
+	assert.Equal(t, "Ludmilla", relatedArtists[0].Name)
+	assert.Equal(t, 7400000, relatedArtists[0].SpotifyMonthlyListeners)
+}
+
+func Test_Client_CrawlRelatedArtists(t *testing.T) {
+	related := map[string][]int{
+		"1": {2, 3},
+		"2": {1, 4},
+		"3": {4, 5},
+		"4": {6},
+	}
+	var requested []string
+
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/{id}/relatedartists": func(w http.ResponseWriter, r *http.Request) {
+			requested = append(requested, r.PathValue("id"))
+
+			var artists []chartmetric.RelatedArtist
+			for _, id := range related[r.PathValue("id")] {
+				artists = append(artists, chartmetric.RelatedArtist{ID: id, Name: fmt.Sprintf("Artist %d", id)})
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]any{"obj": artists})
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	graph, err := client.CrawlRelatedArtists(context.Background(), []int{1}, chartmetric.CrawlRelatedArtistsParams{
+		MaxDepth: 2,
+		MaxNodes: 4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, requested)
+	assert.Len(t, graph.Nodes, 4)
+	assert.Equal(t, 2, graph.Nodes[4].Depth)
+	assert.Equal(t, "Artist 4", graph.Nodes[4].Name)
+	assert.Equal(t, map[int][]int{1: {2, 3}, 2: {1, 4}, 3: {4}}, graph.Edges)
+}
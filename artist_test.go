@@ -0,0 +1,428 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetArtist(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	artist, err := client.GetArtist(context.Background(), 3380)
+	require.NoError(t, err)
+	assert.Equal(t, "Anitta", artist.Name)
+	assert.Equal(t, "BR", artist.CountryCode)
+	require.NotNil(t, artist.Genres.Primary)
+	assert.Equal(t, "funk carioca", artist.Genres.Primary.Name)
+	assert.Len(t, artist.Genres.Secondary, 2)
+	assert.Equal(t, chartmetric.ArtistCareerStageSuperstar, artist.CareerStatus.Stage)
+	assert.Equal(t, 92.4, artist.ChartmetricArtistScore)
+	assert.Equal(t, []string{"7FNnA9vBm6EKceENgCGRMb"}, artist.SpotifyArtistIDs)
+	assert.Equal(t, 2017, artist.CreatedAt.Year())
+	require.NotNil(t, artist.Statistics)
+	assert.Equal(t, 31200000, artist.Statistics.SpotifyMonthlyListeners)
+}
+
+func Test_Client_GetArtistIDs(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/spotify/7FNnA9vBm6EKceENgCGRMb/get-ids": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistIDsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	artistIDs, err := client.GetArtistIDs(context.Background(), chartmetric.ArtistPlatformSpotify, "7FNnA9vBm6EKceENgCGRMb")
+	require.NoError(t, err)
+	assert.Equal(t, 3380, artistIDs.ChartmetricID)
+	assert.Equal(t, []int{570372593}, artistIDs.ITunesIDs)
+	assert.Equal(t, []string{"anitta"}, artistIDs.InstagramIDs)
+}
+
+func Test_Client_GetArtistStats(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/stat/spotify": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2024-03-01", r.URL.Query().Get("since"))
+			assert.Equal(t, "true", r.URL.Query().Get("interpolated"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistStatsSpotifyResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	stats, err := client.GetArtistStats(
+		context.Background(),
+		3380,
+		chartmetric.ArtistStatSourceSpotify,
+		&chartmetric.GetArtistStatsParams{
+			Since:        chartmetric.Opt(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+			Interpolated: chartmetric.Opt(true),
+		},
+	)
+	require.NoError(t, err)
+	assert.Len(t, stats, 3)
+	assert.Len(t, stats[chartmetric.ArtistStatMetricFollowers], 2)
+
+	latest, ok := stats.Latest(chartmetric.ArtistStatMetricMonthlyListeners)
+	require.True(t, ok)
+	assert.Equal(t, 31200000.0, latest.Value)
+	assert.Equal(t, "2024-03-02", latest.Timestamp.Format(chartmetric.DateFormat))
+	assert.True(t, latest.Interpolated)
+
+	_, ok = stats.Latest(chartmetric.ArtistStatMetricViews)
+	assert.False(t, ok)
+}
+
+func Test_Client_GetArtistStats_MalformedSeries(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/stat/spotify": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistStatsMalformedResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	stats, err := client.GetArtistStats(context.Background(), 3380, chartmetric.ArtistStatSourceSpotify, nil)
+	assert.ErrorContains(t, err, "json unmarshal")
+	assert.Nil(t, stats)
+}
+
+func Test_Client_GetArtistCharts(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/spotify_top_weekly/charts": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2022-01-01", r.URL.Query().Get("since"))
+			assert.False(t, r.URL.Query().Has("until"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistChartsSpotifyTopWeeklyResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	entries, err := client.GetArtistCharts(
+		context.Background(),
+		3380,
+		chartmetric.ArtistChartTypeSpotifyTopWeekly,
+		chartmetric.GetArtistChartsParams{Since: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "BR", entries[0].CountryCode)
+	assert.Equal(t, 3, entries[0].Rank)
+	assert.Equal(t, 7, entries[0].PreRank)
+	assert.Equal(t, 2310577, entries[0].Plays)
+	assert.Len(t, entries[0].RankStats, 2)
+	assert.Equal(t, []string{"093624871011"}, entries[0].AlbumUPC)
+}
+
+func Test_Client_GetArtistPlaylists(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/spotify/current/playlists": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("editorial"))
+			assert.Equal(t, "followers", r.URL.Query().Get("sortColumn"))
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistPlaylistsSpotifyCurrentResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	placements, err := client.GetArtistPlaylists(
+		context.Background(),
+		3380,
+		chartmetric.PlaylistPlatformSpotify,
+		chartmetric.PlaylistStatusCurrent,
+		&chartmetric.GetPlaylistPlacementsParams{
+			Limit:      chartmetric.Opt(50),
+			SortColumn: chartmetric.Opt(chartmetric.PlaylistSortColumnFollowers),
+			Editorial:  chartmetric.Opt(true),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, placements, 1)
+	assert.Equal(t, "New Music Friday", placements[0].Playlist.Name)
+	assert.Equal(t, 3900000, placements[0].Playlist.Followers)
+	assert.True(t, placements[0].Playlist.Editorial)
+	assert.True(t, placements[0].Playlist.RemovedAt.IsZero())
+	assert.Equal(t, "USWB12104213", placements[0].Track.ISRC)
+}
+
+func Test_Client_GetArtistWhereListen(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/where-people-listen": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistWhereListenResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	geography, err := client.GetArtistWhereListen(context.Background(), 3380, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-02", geography.Date.Format(chartmetric.DateFormat))
+
+	require.Len(t, geography.Cities, 2)
+	assert.Equal(t, "São Paulo", geography.Cities[0].Name)
+	assert.Equal(t, 1, geography.Cities[0].Rank)
+	assert.Equal(t, -23.5505, geography.Cities[0].Latitude)
+	assert.Equal(t, []int{1850000, 1900000}, []int{geography.Cities[0].History[0].Listeners, geography.Cities[0].History[1].Listeners})
+	assert.Len(t, geography.Cities[1].History, 1)
+
+	require.Len(t, geography.Countries, 2)
+	assert.Equal(t, "BR", geography.Countries[0].CountryCode)
+	assert.Equal(t, 2, geography.Countries[1].Rank)
+}
+
+func Test_Client_GetArtistAudienceStats(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/instagram-audience-stats": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistInstagramAudienceStatsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(10))
+
+	stats, err := client.GetArtistAudienceStats(context.Background(), 3380, chartmetric.AudiencePlatformInstagram, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0123, stats.EngagementRate)
+	assert.Len(t, stats.AudienceGenders, 2)
+	assert.Equal(t, 0.25, stats.AudienceGendersPerAge[0].Female)
+	assert.Equal(t, "Portuguese", stats.AudienceLanguages[0].Name)
+	assert.Equal(t, "Netflix", stats.AudienceBrandAffinities[0].Name)
+	assert.True(t, stats.NotableFollowers[0].IsVerified)
+
+	geography, err := client.GetArtistAudienceGeography(context.Background(), 3380, chartmetric.AudiencePlatformInstagram, nil)
+	require.NoError(t, err)
+	require.Len(t, geography.Countries, 2)
+	assert.Equal(t, "BR", geography.Countries[0].CountryCode)
+	assert.Equal(t, 31200000, geography.Countries[0].Listeners)
+	assert.Equal(t, 1, geography.Cities[0].Rank)
+}
+
+func Test_Client_ListArtistAlbums(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/albums": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "0", r.URL.Query().Get("offset"))
+			assert.Equal(t, "100", r.URL.Query().Get("limit"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistAlbumsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	var albums []chartmetric.AlbumSummary
+	for album, err := range client.ListArtistAlbums(context.Background(), 3380, nil) {
+		require.NoError(t, err)
+		albums = append(albums, album)
+	}
+	require.Len(t, albums, 1)
+	assert.Equal(t, "093624871011", albums[0].UPC)
+	assert.Equal(t, "2022-04-12", albums[0].ReleaseDate.Format(chartmetric.DateFormat))
+}
+
+func Test_Client_ListArtistTracks(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/tracks": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			switch r.URL.Query().Get("offset") {
+			case "0":
+				w.Write([]byte(testdata.ArtistTracksPage1Response))
+			case "2":
+				w.Write([]byte(testdata.ArtistTracksPage2Response))
+			default:
+				t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+			}
+		},
+		"GET /track/chartmetric/{id}/get-ids": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TrackIDsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	var tracks []chartmetric.TrackSummary
+	for track, err := range client.ListArtistTracks(context.Background(), 3380, &chartmetric.ListArtistTracksParams{
+		PageSize:  chartmetric.Opt(2),
+		ExpandIDs: true,
+	}) {
+		require.NoError(t, err)
+		tracks = append(tracks, track)
+	}
+	require.Len(t, tracks, 3)
+	assert.Equal(t, "Funk Rave", tracks[2].Name)
+	require.NotNil(t, tracks[0].IDs)
+	assert.Equal(t, []string{"3ebXMykcMXOcLeJ9xZ17XH"}, tracks[0].IDs.SpotifyIDs)
+}
+
+func Test_Client_GetArtistEvents(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/past/events": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistPastEventsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	events, err := client.GetArtistEvents(context.Background(), 3380, chartmetric.ArtistEventStatusPast)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Marina da Glória", events[0].VenueName)
+	assert.Equal(t, 15000, events[0].VenueCapacity)
+	assert.Equal(t, "2024-03-05", events[0].Date.Format(chartmetric.DateFormat))
+}
+
+func Test_EventMetricChanges(t *testing.T) {
+	day := func(d int) chartmetric.Date {
+		return chartmetric.Date{Time: time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)}
+	}
+	series := []chartmetric.StatPoint{
+		{Value: 1200, Timestamp: day(8)},
+		{Value: 1000, Timestamp: day(1)},
+		{Value: 1100, Timestamp: day(5)},
+	}
+	events := []chartmetric.ArtistEvent{
+		{ID: 1, Date: day(5)},
+		{ID: 2, Date: day(1)},
+		// after the last data point, both points would be before the event
+		{ID: 3, Date: day(10)},
+	}
+
+	changes := chartmetric.EventMetricChanges(events, series, 3*24*time.Hour)
+	require.Len(t, changes, 1)
+	assert.Equal(t, 1, changes[0].Event.ID)
+	assert.Equal(t, 1000.0, changes[0].Before.Value)
+	assert.Equal(t, 1200.0, changes[0].After.Value)
+	assert.Equal(t, 200.0, changes[0].Change)
+	assert.Equal(t, 20.0, changes[0].ChangePercent)
+}
+
+func Test_Client_GetArtistCMScoreAndRank(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/cmScore": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistCMScoreResponse))
+		},
+		"GET /artist/3380/cmRank": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "genre", r.URL.Query().Get("type"))
+			assert.Equal(t, "latin", r.URL.Query().Get("genre"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistCMRankGenreResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	scores, err := client.GetArtistCMScore(context.Background(), 3380, nil)
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+	assert.Equal(t, 92.4, scores[1].Value)
+	assert.Equal(t, "2024-03-02", scores[1].Timestamp.Format(chartmetric.DateFormat))
+
+	ranks, err := client.GetArtistCMRank(context.Background(), 3380, chartmetric.GetArtistCMRankParams{
+		Scope: chartmetric.ArtistRankScopeGenre,
+		Genre: chartmetric.Opt("latin"),
+	})
+	require.NoError(t, err)
+	require.Len(t, ranks, 2)
+	assert.Equal(t, 2, ranks[1].Rank)
+}
+
+func Test_Client_GetArtistCMRank_Scope(t *testing.T) {
+	var scopes []string
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/cmRank": func(w http.ResponseWriter, r *http.Request) {
+			scopes = append(scopes, r.URL.Query().Get("type"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistCMRankGenreResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	_, err := client.GetArtistCMRank(context.Background(), 3380, chartmetric.GetArtistCMRankParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"overall"}, scopes)
+
+	_, err = client.GetArtistCMRank(context.Background(), 3380, chartmetric.GetArtistCMRankParams{Scope: chartmetric.ArtistRankScopeGenre})
+	assert.ErrorContains(t, err, "genre is required")
+
+	_, err = client.GetArtistCMRank(context.Background(), 3380, chartmetric.GetArtistCMRankParams{
+		Scope:       chartmetric.ArtistRankScopeCountry,
+		CountryCode: chartmetric.Opt(""),
+	})
+	assert.ErrorContains(t, err, "country code is required")
+
+	// invalid params are rejected before any request
+	assert.Len(t, scopes, 1)
+}
@@ -0,0 +1,118 @@
+package chartmetric
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type ArtistTimelineEventType string
+
+const (
+	ArtistTimelineEventTypeInsight     ArtistTimelineEventType = "insight"
+	ArtistTimelineEventTypeCareerStage ArtistTimelineEventType = "career_stage"
+	ArtistTimelineEventTypeChartEntry  ArtistTimelineEventType = "chart_entry"
+)
+
+// ArtistTimelineEvent is a single event of an ArtistTimeline.
+// Payload holds the underlying record: an ArtistInsight, an ArtistCareerPoint or a ChartHistoryEntry,
+// depending on Type.
+type ArtistTimelineEvent struct {
+	Type     ArtistTimelineEventType `json:"type"`
+	Date     Date                    `json:"date"`
+	Platform string                  `json:"platform,omitempty"`
+	Payload  any                     `json:"payload"`
+}
+
+// ArtistTimeline is a chronological stream of events in an artist's career.
+type ArtistTimeline []ArtistTimelineEvent
+
+type GetArtistTimelineParams struct {
+	Since time.Time
+	Until Optional[time.Time]
+	// Insights holds the params of the noteworthy insights request.
+	Insights *GetArtistInsightsParams
+	// ChartTypes lists the charts whose entries are added to the timeline. Each costs one request.
+	ChartTypes []ArtistChartType
+}
+
+// GetArtistTimeline merges an artist's noteworthy insights, career stage changes and chart entries
+// into one chronological timeline. A career stage change is dated by the first data point of the new stage.
+func (c *Client) GetArtistTimeline(ctx context.Context, id int, params GetArtistTimelineParams) (ArtistTimeline, error) {
+	var timeline ArtistTimeline
+	// events are dated, so the range is compared by date too, like the API does
+	since := truncateToDate(params.Since)
+	inRange := func(date Date) bool {
+		if date.Before(since) {
+			return false
+		}
+		return params.Until == nil || !date.After(truncateToDate(*params.Until))
+	}
+
+	insights, err := c.GetArtistInsights(ctx, id, params.Insights)
+	if err != nil {
+		return nil, fmt.Errorf("get artist insights: %w", err)
+	}
+	for _, insight := range insights {
+		if !inRange(insight.Timestamp) {
+			continue
+		}
+		timeline = append(timeline, ArtistTimelineEvent{
+			Type:     ArtistTimelineEventTypeInsight,
+			Date:     insight.Timestamp,
+			Platform: insight.Platform,
+			Payload:  insight,
+		})
+	}
+
+	career, err := c.GetArtistCareer(ctx, id, &GetArtistCareerParams{Since: Opt(params.Since), Until: params.Until})
+	if err != nil {
+		return nil, fmt.Errorf("get artist career: %w", err)
+	}
+	slices.SortStableFunc(career, func(a, b ArtistCareerPoint) int {
+		return a.Timestamp.Compare(b.Timestamp.Time)
+	})
+	// only stage changes are events, not every data point, nor the stage the artist starts the range in
+	for i, point := range career {
+		if i == 0 || point.Stage == career[i-1].Stage {
+			continue
+		}
+		timeline = append(timeline, ArtistTimelineEvent{
+			Type:    ArtistTimelineEventTypeCareerStage,
+			Date:    point.Timestamp,
+			Payload: point,
+		})
+	}
+
+	for _, chartType := range params.ChartTypes {
+		entries, err := c.GetArtistCharts(ctx, id, chartType, GetArtistChartsParams{Since: params.Since, Until: params.Until})
+		if err != nil {
+			return nil, fmt.Errorf("get artist charts %s: %w", chartType, err)
+		}
+		platform, _, _ := strings.Cut(string(chartType), "_")
+		for _, entry := range entries {
+			// chart entries are timestamped, every other event is dated
+			if entry.AddedAt.IsZero() {
+				continue
+			}
+			date := Date{Time: truncateToDate(entry.AddedAt)}
+			if !inRange(date) {
+				continue
+			}
+			timeline = append(timeline, ArtistTimelineEvent{
+				Type:     ArtistTimelineEventTypeChartEntry,
+				Date:     date,
+				Platform: platform,
+				Payload:  entry,
+			})
+		}
+	}
+
+	slices.SortStableFunc(timeline, func(a, b ArtistTimelineEvent) int {
+		return a.Date.Compare(b.Date.Time)
+	})
+
+	return timeline, nil
+}
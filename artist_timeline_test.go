@@ -0,0 +1,126 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetArtistTimeline(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/noteworthy-insights": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "5", r.URL.Query().Get("weight"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistInsightsResponse))
+		},
+		"GET /artist/3380/career": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2022-01-01", r.URL.Query().Get("since"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistCareerResponse))
+		},
+		"GET /artist/3380/spotify_top_weekly/charts": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistChartsSpotifyTopWeeklyResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	timeline, err := client.GetArtistTimeline(context.Background(), 3380, chartmetric.GetArtistTimelineParams{
+		Since:      time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		Insights:   &chartmetric.GetArtistInsightsParams{Weight: chartmetric.Opt(5)},
+		ChartTypes: []chartmetric.ArtistChartType{chartmetric.ArtistChartTypeSpotifyTopWeekly},
+	})
+	require.NoError(t, err)
+	require.Len(t, timeline, 4)
+
+	var types []chartmetric.ArtistTimelineEventType
+	var dates []string
+	for _, event := range timeline {
+		types = append(types, event.Type)
+		dates = append(dates, event.Date.Format(chartmetric.DateFormat))
+	}
+	// the mid-level stage the artist starts in is not a change
+	assert.Equal(t, []chartmetric.ArtistTimelineEventType{
+		chartmetric.ArtistTimelineEventTypeCareerStage,
+		chartmetric.ArtistTimelineEventTypeInsight,
+		chartmetric.ArtistTimelineEventTypeChartEntry,
+		chartmetric.ArtistTimelineEventTypeChartEntry,
+	}, types)
+	assert.Equal(t, []string{"2022-03-01", "2022-03-15", "2022-04-07", "2022-04-07"}, dates)
+
+	assert.Equal(t, chartmetric.ArtistCareerStageMainstream, timeline[0].Payload.(chartmetric.ArtistCareerPoint).Stage)
+	assert.Equal(t, "spotify", timeline[2].Platform)
+	assert.Equal(t, "BR", timeline[2].Payload.(chartmetric.ChartHistoryEntry).CountryCode)
+}
+
+func Test_Client_GetArtistTimeline_ChartEntryDates(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/noteworthy-insights": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistInsightsResponse))
+		},
+		"GET /artist/3380/career": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistCareerResponse))
+		},
+		"GET /artist/3380/spotify_top_weekly/charts": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistChartsTimestampEdgeCasesResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	timeline, err := client.GetArtistTimeline(context.Background(), 3380, chartmetric.GetArtistTimelineParams{
+		Since:      time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		ChartTypes: []chartmetric.ArtistChartType{chartmetric.ArtistChartTypeSpotifyTopWeekly},
+	})
+	require.NoError(t, err)
+
+	// the entry before Since and the one without a date are left out
+	var chartEvents []chartmetric.ArtistTimelineEvent
+	for _, event := range timeline {
+		assert.False(t, event.Date.Before(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)))
+		if event.Type == chartmetric.ArtistTimelineEventTypeChartEntry {
+			chartEvents = append(chartEvents, event)
+		}
+	}
+	require.Len(t, chartEvents, 1)
+	assert.Equal(t, "Envolver", chartEvents[0].Payload.(chartmetric.ChartHistoryEntry).Name)
+	assert.Equal(t, time.Date(2022, 4, 7, 0, 0, 0, 0, time.UTC), chartEvents[0].Date.Time)
+
+	// a time of day in the range doesn't leave out the events of that day
+	timeline, err = client.GetArtistTimeline(context.Background(), 3380, chartmetric.GetArtistTimelineParams{
+		Since:      time.Date(2022, 3, 15, 18, 30, 0, 0, time.UTC),
+		Until:      chartmetric.Opt(time.Date(2022, 4, 7, 6, 0, 0, 0, time.UTC)),
+		ChartTypes: []chartmetric.ArtistChartType{chartmetric.ArtistChartTypeSpotifyTopWeekly},
+	})
+	require.NoError(t, err)
+
+	var dates []string
+	for _, event := range timeline {
+		if event.Type != chartmetric.ArtistTimelineEventTypeCareerStage {
+			dates = append(dates, event.Date.Format(chartmetric.DateFormat))
+		}
+	}
+	assert.Equal(t, []string{"2022-03-15", "2022-04-07"}, dates)
+}
@@ -0,0 +1,145 @@
+package chartmetric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type getArtistURLsResponse struct {
+	Obj []struct {
+		Domain ArtistPlatform `json:"domain"`
+		URLs   []string       `json:"url"`
+	} `json:"obj"`
+}
+
+// ArtistURLs are an artist's profile URLs, keyed by platform.
+type ArtistURLs map[ArtistPlatform][]string
+
+// GetArtistURLs fetches the profile URLs of an artist across platforms.
+// See https://api.chartmetric.com/apidoc/#api-Artist-GetArtistURLs.
+func (c *Client) GetArtistURLs(ctx context.Context, id int) (ArtistURLs, error) {
+	path := fmt.Sprintf("/artist/%d/urls", id)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getArtistURLsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	urls := make(ArtistURLs, len(response.Obj))
+	for _, entry := range response.Obj {
+		urls[entry.Domain] = append(urls[entry.Domain], entry.URLs...)
+	}
+
+	return urls, nil
+}
+
+// ==================================================
+
+var (
+	spotifyIDRegexp            = regexp.MustCompile(`^[0-9A-Za-z]{22}$`)
+	spotifyArtistPathRegexp    = regexp.MustCompile(`^(?:/intl-[a-z-]+)?/artist/([0-9A-Za-z]{22})/?$`)
+	deezerArtistPathRegexp     = regexp.MustCompile(`^(?:/[a-z]{2})?/artist/(\d+)/?$`)
+	appleMusicArtistPathRegexp = regexp.MustCompile(`^(?:/[a-z]{2})?/artist/(?:[^/]+/)?(?:id)?(\d+)/?$`)
+	youTubeChannelPathRegexp   = regexp.MustCompile(`^/channel/(UC[0-9A-Za-z_-]{22})/?$`)
+	youTubeCustomPathRegexp    = regexp.MustCompile(`^/(?:@[^/]+|c/[^/]+|user/[^/]+)/?$`)
+	handlePathRegexp           = regexp.MustCompile(`^/@?([0-9A-Za-z._-]+)/?$`)
+	tikTokHandlePathRegexp     = regexp.MustCompile(`^/@([0-9A-Za-z._]+)/?$`)
+)
+
+// reservedProfilePaths are first path segments that look like handles but are not profiles.
+var reservedProfilePaths = map[string]bool{
+	"explore": true, "home": true, "search": true, "share": true, "login": true, "discover": true,
+	"p": true, "reel": true, "reels": true, "stories": true, "watch": true, "intent": true, "i": true,
+	"profile.php": true, "pages": true, "groups": true,
+}
+
+// ParseArtistProfileURL parses a known artist profile URL (Spotify, Apple Music, Deezer, YouTube channel,
+// Instagram, TikTok, SoundCloud, Twitter/X or Facebook) into its platform and the artist's ID on that platform.
+// Handle-based platforms (Instagram, TikTok, SoundCloud, Twitter/X, Facebook) return the handle as ID.
+// Spotify URIs (spotify:artist:<id>) are accepted too.
+// YouTube handle, custom and legacy user URLs (youtube.com/@<handle>, /c/<name>, /user/<name>) don't carry
+// the channel ID that Chartmetric expects, so they are rejected: the channel's /channel/<id> URL must be used.
+func ParseArtistProfileURL(rawURL string) (ArtistPlatform, string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if id, ok := strings.CutPrefix(rawURL, "spotify:artist:"); ok {
+		if !spotifyIDRegexp.MatchString(id) {
+			return "", "", fmt.Errorf("invalid spotify artist id %q", id)
+		}
+		return ArtistPlatformSpotify, id, nil
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("parse url: %w", err)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+
+	var platform ArtistPlatform
+	var match []string
+	switch host {
+	case "open.spotify.com":
+		platform, match = ArtistPlatformSpotify, spotifyArtistPathRegexp.FindStringSubmatch(u.Path)
+	case "deezer.com":
+		platform, match = ArtistPlatformDeezer, deezerArtistPathRegexp.FindStringSubmatch(u.Path)
+	case "music.apple.com", "itunes.apple.com":
+		platform, match = ArtistPlatformITunes, appleMusicArtistPathRegexp.FindStringSubmatch(u.Path)
+	case "youtube.com", "music.youtube.com":
+		platform, match = ArtistPlatformYouTube, youTubeChannelPathRegexp.FindStringSubmatch(u.Path)
+		if match == nil && youTubeCustomPathRegexp.MatchString(u.Path) {
+			return "", "", fmt.Errorf("youtube url path %q has no channel id, use the channel's /channel/<id> url", u.Path)
+		}
+	case "tiktok.com":
+		platform, match = ArtistPlatformTikTok, tikTokHandlePathRegexp.FindStringSubmatch(u.Path)
+	case "instagram.com":
+		platform, match = ArtistPlatformInstagram, handlePathRegexp.FindStringSubmatch(u.Path)
+	case "soundcloud.com":
+		platform, match = ArtistPlatformSoundCloud, handlePathRegexp.FindStringSubmatch(u.Path)
+	case "twitter.com", "x.com":
+		platform, match = ArtistPlatformTwitter, handlePathRegexp.FindStringSubmatch(u.Path)
+	case "facebook.com", "fb.com":
+		platform = ArtistPlatformFacebook
+		if id := u.Query().Get("id"); u.Path == "/profile.php" && id != "" {
+			return platform, id, nil
+		}
+		match = handlePathRegexp.FindStringSubmatch(u.Path)
+	default:
+		return "", "", fmt.Errorf("unsupported host %q", host)
+	}
+
+	if match == nil || reservedProfilePaths[strings.ToLower(match[1])] {
+		return "", "", fmt.Errorf("unsupported %s artist url path %q", platform, u.Path)
+	}
+
+	return platform, match[1], nil
+}
+
+// ResolveArtistProfileURL parses a known artist profile URL (see ParseArtistProfileURL),
+// then looks up the artist's Chartmetric ID and IDs on other platforms with GetArtistIDs.
+func (c *Client) ResolveArtistProfileURL(ctx context.Context, rawURL string) (*ArtistIDs, error) {
+	platform, id, err := ParseArtistProfileURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse artist profile url: %w", err)
+	}
+
+	artistIDs, err := c.GetArtistIDs(ctx, platform, id)
+	if err != nil {
+		return nil, fmt.Errorf("get artist IDs: %w", err)
+	}
+
+	return artistIDs, nil
+}
@@ -0,0 +1,101 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetArtistURLs(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/3380/urls": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistURLsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	urls, err := client.GetArtistURLs(context.Background(), 3380)
+	require.NoError(t, err)
+	assert.Len(t, urls, 3)
+	assert.Equal(t, []string{"https://www.instagram.com/anitta"}, urls[chartmetric.ArtistPlatformInstagram])
+}
+
+func Test_Client_ResolveArtistProfileURL(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /artist/spotify/7FNnA9vBm6EKceENgCGRMb/get-ids": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ArtistIDsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	artistIDs, err := client.ResolveArtistProfileURL(context.Background(), "https://open.spotify.com/intl-pt/artist/7FNnA9vBm6EKceENgCGRMb?si=abc")
+	require.NoError(t, err)
+	assert.Equal(t, 3380, artistIDs.ChartmetricID)
+
+	_, err = client.ResolveArtistProfileURL(context.Background(), "https://example.com/anitta")
+	assert.ErrorContains(t, err, "unsupported host")
+}
+
+func Test_ParseArtistProfileURL(t *testing.T) {
+	tests := []struct {
+		url          string
+		wantPlatform chartmetric.ArtistPlatform
+		wantID       string
+		wantErr      bool
+	}{
+		{url: "https://open.spotify.com/artist/7FNnA9vBm6EKceENgCGRMb", wantPlatform: chartmetric.ArtistPlatformSpotify, wantID: "7FNnA9vBm6EKceENgCGRMb"},
+		{url: "https://open.spotify.com/intl-pt/artist/7FNnA9vBm6EKceENgCGRMb?si=x1y2", wantPlatform: chartmetric.ArtistPlatformSpotify, wantID: "7FNnA9vBm6EKceENgCGRMb"},
+		{url: "spotify:artist:7FNnA9vBm6EKceENgCGRMb", wantPlatform: chartmetric.ArtistPlatformSpotify, wantID: "7FNnA9vBm6EKceENgCGRMb"},
+		{url: "https://music.apple.com/br/artist/anitta/570372593", wantPlatform: chartmetric.ArtistPlatformITunes, wantID: "570372593"},
+		{url: "https://itunes.apple.com/us/artist/anitta/id570372593", wantPlatform: chartmetric.ArtistPlatformITunes, wantID: "570372593"},
+		{url: "https://www.deezer.com/en/artist/4997593", wantPlatform: chartmetric.ArtistPlatformDeezer, wantID: "4997593"},
+		{url: "deezer.com/artist/4997593", wantPlatform: chartmetric.ArtistPlatformDeezer, wantID: "4997593"},
+		{url: "https://www.youtube.com/channel/UCeuT-2XIGyEyl7CqmKfk3Og", wantPlatform: chartmetric.ArtistPlatformYouTube, wantID: "UCeuT-2XIGyEyl7CqmKfk3Og"},
+		{url: "https://music.youtube.com/channel/UCeuT-2XIGyEyl7CqmKfk3Og", wantPlatform: chartmetric.ArtistPlatformYouTube, wantID: "UCeuT-2XIGyEyl7CqmKfk3Og"},
+		{url: "https://www.instagram.com/anitta/", wantPlatform: chartmetric.ArtistPlatformInstagram, wantID: "anitta"},
+		{url: "https://www.tiktok.com/@anitta?lang=en", wantPlatform: chartmetric.ArtistPlatformTikTok, wantID: "anitta"},
+		{url: "https://soundcloud.com/anitta-official", wantPlatform: chartmetric.ArtistPlatformSoundCloud, wantID: "anitta-official"},
+		{url: "https://twitter.com/Anitta", wantPlatform: chartmetric.ArtistPlatformTwitter, wantID: "Anitta"},
+		{url: "https://x.com/Anitta", wantPlatform: chartmetric.ArtistPlatformTwitter, wantID: "Anitta"},
+		{url: "https://m.facebook.com/anitta", wantPlatform: chartmetric.ArtistPlatformFacebook, wantID: "anitta"},
+		{url: "https://www.facebook.com/profile.php?id=100044181214720", wantPlatform: chartmetric.ArtistPlatformFacebook, wantID: "100044181214720"},
+		{url: "https://open.spotify.com/track/3ebXMykcMXOcLeJ9xZ17XH", wantErr: true},
+		{url: "spotify:artist:7FNnA9vBm6EKceENgCGRMb?si=1", wantErr: true},
+		{url: "spotify:artist:", wantErr: true},
+		{url: "https://www.youtube.com/watch?v=fHEDy_Ryxx4", wantErr: true},
+		{url: "https://www.youtube.com/@anitta", wantErr: true},
+		{url: "https://www.youtube.com/c/Anitta", wantErr: true},
+		{url: "https://www.youtube.com/user/anittaoficial", wantErr: true},
+		{url: "https://www.instagram.com/explore/", wantErr: true},
+		{url: "https://www.tiktok.com/music/Envolver-7030066223532019713", wantErr: true},
+		{url: "https://example.com/anitta", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			platform, id, err := chartmetric.ParseArtistProfileURL(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPlatform, platform)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
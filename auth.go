@@ -3,7 +3,6 @@ package chartmetric
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -22,17 +21,16 @@ type accessToken struct {
 }
 
 func (c *Client) resolveAccessToken(ctx context.Context) (string, error) {
-	if c.accessToken == nil || time.Now().After(c.accessToken.expiresAt) {
+	// held during the fetch, so that concurrent requests wait for a single token refresh
+	c.accessTokenMu.Lock()
+	defer c.accessTokenMu.Unlock()
+
+	if c.accessToken == nil || c.clock.Now().After(c.accessToken.expiresAt) {
 		fetchedToken, err := retry.DoWithData(
 			func() (*accessToken, error) {
 				return c.fetchAccessToken(ctx)
 			},
-			retry.Context(ctx),
-			retry.Attempts(c.retryAttempts),
-			retry.Delay(c.retryDelay),
-			retry.RetryIf(func(err error) bool {
-				return errors.Is(err, errRateLimitExceeded) || errors.Is(err, errTemporarilyUnavailable)
-			}),
+			c.retryOptions(ctx)...,
 		)
 		if err != nil {
 			return "", fmt.Errorf("fetch access token: %w", err)
@@ -84,6 +82,6 @@ func (c *Client) fetchAccessToken(ctx context.Context) (*accessToken, error) {
 
 	return &accessToken{
 		value:     tokenResponse.Token,
-		expiresAt: time.Now().Add(time.Second * time.Duration(tokenResponse.ExpiresIn)).Add(-time.Second * 5), // with 5 sec allowance
+		expiresAt: c.clock.Now().Add(time.Second * time.Duration(tokenResponse.ExpiresIn)).Add(-c.tokenExpiryMargin),
 	}, nil
 }
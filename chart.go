@@ -625,3 +625,70 @@ func (c *Client) GetChartEntriesAirplay(ctx context.Context, params GetChartEntr
 
 	return response.Obj.Data, nil
 }
+
+// ==================================================
+
+// ChartHistoryEntry is a single appearance of an artist, album or track on a chart,
+// as returned by the per-entity chart history endpoints.
+type ChartHistoryEntry struct {
+	ID                   int             `json:"id"`
+	Name                 string          `json:"name"`
+	ISRC                 string          `json:"isrc"`
+	ImageURL             string          `json:"image_url"`
+	ChartmetricTrackID   int             `json:"cm_track"`
+	ChartmetricArtistIDs []int           `json:"cm_artist"`
+	ArtistNames          []string        `json:"artist_names"`
+	ChartmetricAlbumIDs  []int           `json:"album_ids"`
+	AlbumNames           []string        `json:"album_names"`
+	AlbumUPC             []string        `json:"album_upc"`
+	AlbumLabel           []string        `json:"album_label"`
+	ReleaseDates         []Date          `json:"release_dates"`
+	ChartName            string          `json:"chart_name"`
+	ChartType            string          `json:"chart_type"`
+	CountryCode          string          `json:"code2"`
+	Rank                 int             `json:"rank"`
+	PreRank              int             `json:"pre_rank"`
+	PeakRank             int             `json:"peak_rank"`
+	PeakDate             time.Time       `json:"peak_date"`
+	AddedAt              time.Time       `json:"added_at"`
+	TimeOnChart          int             `json:"time_on_chart"`
+	Velocity             float64         `json:"velocity"`
+	Plays                int             `json:"plays"`
+	Posts                int             `json:"posts"`
+	Views                int             `json:"views"`
+	RankStats            []ChartRankStat `json:"rank_stats"`
+}
+
+type ChartRankStat struct {
+	Rank      int       `json:"rank"`
+	Plays     int       `json:"plays"`
+	Posts     int       `json:"posts"`
+	Timestamp time.Time `json:"timestp"`
+}
+
+type getChartHistoryResponse struct {
+	Obj struct {
+		Length int                 `json:"length"`
+		Data   []ChartHistoryEntry `json:"data"`
+	} `json:"obj"`
+}
+
+func (c *Client) getChartHistory(ctx context.Context, path string, since time.Time, until Optional[time.Time]) ([]ChartHistoryEntry, error) {
+	queryParams := make(map[string]any)
+	queryParams["since"] = since.Format(DateFormat)
+	if until != nil {
+		queryParams["until"] = (*until).Format(DateFormat)
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getChartHistoryResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj.Data, nil
+}
@@ -0,0 +1,83 @@
+package chartmetrictest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+)
+
+// FakeClock is a manually driven chartmetric.Clock.
+// Time only moves when Advance or Set is called, which fires any timers that became due.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeClockWaiter
+}
+
+type fakeClockWaiter struct {
+	until time.Time
+	ch    chan time.Time
+}
+
+var _ chartmetric.Clock = (*FakeClock)(nil)
+
+// NewFakeClock is the constructor for FakeClock, starting at the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current fake time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After returns a channel that receives the fake time once the clock has been advanced by at least d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeClockWaiter{until: c.now.Add(d), ch: ch})
+
+	return ch
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, firing every timer due at or before t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+
+	pending := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.until.After(t) {
+			pending = append(pending, waiter)
+			continue
+		}
+		waiter.ch <- t
+	}
+	c.waiters = pending
+}
+
+// Waiters returns the number of timers that have not fired yet.
+// Tests can poll it to know when the code under test is blocked on the clock.
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
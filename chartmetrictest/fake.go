@@ -0,0 +1,567 @@
+// Package chartmetrictest provides test helpers for code that depends on the Chartmetric client.
+package chartmetrictest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+)
+
+// ErrNotConfigured is returned by Fake methods whose response function has not been set.
+var ErrNotConfigured = errors.New("fake method not configured")
+
+// Call is a single recorded call made against a Fake.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Fake is an in-memory implementation of chartmetric.API.
+// Responses are programmed by setting the <Method>Func fields, and every call is recorded
+// (see Calls and CallsTo). Methods without a configured function return ErrNotConfigured.
+//
+// Fake mirrors chartmetric.API method-for-method; the compile-time assertion below
+// breaks the build whenever the interface grows without the Fake following.
+type Fake struct {
+	GetAnyFunc func(ctx context.Context, path string, queryParams map[string]any) ([]byte, error)
+
+	GetChartCountriesFunc         func(ctx context.Context, platform chartmetric.ChartPlatform, params *chartmetric.GetChartCountriesParams) ([]string, error)
+	GetChartTracksSpotifyFunc     func(ctx context.Context, params chartmetric.GetChartTracksSpotifyParams) ([]chartmetric.ChartTrackSpotify, error)
+	GetChartArtistsSpotifyFunc    func(ctx context.Context, params chartmetric.GetChartArtistSpotifyParams) ([]chartmetric.ChartArtistSpotify, error)
+	GetChartEntriesTikTokFunc     func(ctx context.Context, params chartmetric.GetChartEntriesTikTokParams) ([]chartmetric.ChartEntryTikTok, error)
+	GetChartEntriesAppleMusicFunc func(ctx context.Context, params chartmetric.GetChartEntriesAppleMusicParams) ([]chartmetric.ChartEntryAppleMusic, error)
+	GetChartEntriesAirplayFunc    func(ctx context.Context, params chartmetric.GetChartEntriesAirplayParams) ([]chartmetric.ChartEntryAirplay, error)
+
+	GetArtistFunc                  func(ctx context.Context, id int) (*chartmetric.Artist, error)
+	GetArtistIDsFunc               func(ctx context.Context, platform chartmetric.ArtistPlatform, id string) (*chartmetric.ArtistIDs, error)
+	GetArtistStatsFunc             func(ctx context.Context, id int, source chartmetric.ArtistStatSource, params *chartmetric.GetArtistStatsParams) (chartmetric.ArtistStats, error)
+	GetArtistChartsFunc            func(ctx context.Context, id int, chartType chartmetric.ArtistChartType, params chartmetric.GetArtistChartsParams) ([]chartmetric.ChartHistoryEntry, error)
+	GetArtistPlaylistsFunc         func(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error)
+	GetArtistWhereListenFunc       func(ctx context.Context, id int, params *chartmetric.GetArtistWhereListenParams) (*chartmetric.ListenerGeography, error)
+	GetArtistAudienceGeographyFunc func(ctx context.Context, id int, platform chartmetric.AudiencePlatform, params *chartmetric.GetArtistAudienceParams) (*chartmetric.ListenerGeography, error)
+	GetArtistAudienceStatsFunc     func(ctx context.Context, id int, platform chartmetric.AudiencePlatform, params *chartmetric.GetArtistAudienceParams) (*chartmetric.AudienceStats, error)
+	GetRelatedArtistsFunc          func(ctx context.Context, id int, params *chartmetric.GetRelatedArtistsParams) ([]chartmetric.RelatedArtist, error)
+	CrawlRelatedArtistsFunc        func(ctx context.Context, seeds []int, params chartmetric.CrawlRelatedArtistsParams) (*chartmetric.ArtistGraph, error)
+	ListArtistAlbumsFunc           func(ctx context.Context, id int, params *chartmetric.ListArtistAlbumsParams) iter.Seq2[chartmetric.AlbumSummary, error]
+	ListArtistTracksFunc           func(ctx context.Context, id int, params *chartmetric.ListArtistTracksParams) iter.Seq2[chartmetric.TrackSummary, error]
+	GetArtistInsightsFunc          func(ctx context.Context, id int, params *chartmetric.GetArtistInsightsParams) ([]chartmetric.ArtistInsight, error)
+	GetArtistCareerFunc            func(ctx context.Context, id int, params *chartmetric.GetArtistCareerParams) ([]chartmetric.ArtistCareerPoint, error)
+	GetArtistTimelineFunc          func(ctx context.Context, id int, params chartmetric.GetArtistTimelineParams) (chartmetric.ArtistTimeline, error)
+	FilterArtistsFunc              func(ctx context.Context, filter *chartmetric.ArtistFilter) iter.Seq2[chartmetric.FilteredArtist, error]
+	GetArtistEventsFunc            func(ctx context.Context, id int, status chartmetric.ArtistEventStatus) ([]chartmetric.ArtistEvent, error)
+	GetArtistCMScoreFunc           func(ctx context.Context, id int, params *chartmetric.GetArtistCMScoreParams) ([]chartmetric.StatPoint, error)
+	GetArtistCMRankFunc            func(ctx context.Context, id int, params chartmetric.GetArtistCMRankParams) ([]chartmetric.ArtistRankPoint, error)
+	GetArtistURLsFunc              func(ctx context.Context, id int) (chartmetric.ArtistURLs, error)
+	ResolveArtistProfileURLFunc    func(ctx context.Context, rawURL string) (*chartmetric.ArtistIDs, error)
+
+	GetAlbumFunc           func(ctx context.Context, id int) (*chartmetric.Album, error)
+	GetAlbumTracksFunc     func(ctx context.Context, id int) ([]chartmetric.TrackSummary, error)
+	GetAlbumIDsFunc        func(ctx context.Context, platform chartmetric.AlbumPlatform, id string) (*chartmetric.AlbumIDs, error)
+	GetAlbumStatsFunc      func(ctx context.Context, id int, source chartmetric.AlbumStatSource, params *chartmetric.GetAlbumStatsParams) (chartmetric.AlbumStats, error)
+	GetAlbumChartsFunc     func(ctx context.Context, id int, chartType chartmetric.AlbumChartType, params chartmetric.GetAlbumChartsParams) ([]chartmetric.ChartHistoryEntry, error)
+	GetAlbumPlaylistsFunc  func(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error)
+	ListAlbumPlaylistsFunc func(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) iter.Seq2[chartmetric.PlaylistPlacement, error]
+
+	GetTrackFunc          func(ctx context.Context, id int) (*chartmetric.Track, error)
+	GetTrackIDsFunc       func(ctx context.Context, platform chartmetric.TrackPlatform, id string) (*chartmetric.TrackIDs, error)
+	ResolveTrackIDsFunc   func(ctx context.Context, platform chartmetric.TrackPlatform, ids []string, opts *chartmetric.ResolveTrackIDsOptions) []chartmetric.TrackIDsResult
+	GetTrackStatsFunc     func(ctx context.Context, id int, platform chartmetric.TrackStatPlatform, params *chartmetric.GetTrackStatsParams) ([]chartmetric.TrackStatSeries, error)
+	GetTrackChartsFunc    func(ctx context.Context, id int, chartType chartmetric.TrackChartType, params chartmetric.GetTrackChartsParams) ([]chartmetric.ChartHistoryEntry, error)
+	GetTrackPlaylistsFunc func(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error)
+
+	SearchFunc        func(ctx context.Context, query string, params *chartmetric.SearchParams) (*chartmetric.SearchResults, error)
+	ResolveArtistFunc func(ctx context.Context, name string, hints *chartmetric.ResolveArtistHints) (*chartmetric.ArtistMatch, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ chartmetric.API = (*Fake)(nil)
+
+// Calls returns all calls recorded so far, in order.
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls made to a particular method, in order.
+func (f *Fake) CallsTo(method string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []Call
+	for _, call := range f.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset clears the recorded calls. Configured response functions are kept.
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = nil
+}
+
+func (f *Fake) record(method string, args ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+}
+
+func notConfigured(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotConfigured)
+}
+
+func notConfiguredSeq[T any](method string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, notConfigured(method))
+	}
+}
+
+// GetAny records the call and delegates to GetAnyFunc.
+func (f *Fake) GetAny(ctx context.Context, path string, queryParams map[string]any) ([]byte, error) {
+	f.record("GetAny", path, queryParams)
+	if f.GetAnyFunc == nil {
+		return nil, notConfigured("GetAny")
+	}
+
+	return f.GetAnyFunc(ctx, path, queryParams)
+}
+
+// GetChartCountries records the call and delegates to GetChartCountriesFunc.
+func (f *Fake) GetChartCountries(ctx context.Context, platform chartmetric.ChartPlatform, params *chartmetric.GetChartCountriesParams) ([]string, error) {
+	f.record("GetChartCountries", platform, params)
+	if f.GetChartCountriesFunc == nil {
+		return nil, notConfigured("GetChartCountries")
+	}
+
+	return f.GetChartCountriesFunc(ctx, platform, params)
+}
+
+// GetChartTracksSpotify records the call and delegates to GetChartTracksSpotifyFunc.
+func (f *Fake) GetChartTracksSpotify(ctx context.Context, params chartmetric.GetChartTracksSpotifyParams) ([]chartmetric.ChartTrackSpotify, error) {
+	f.record("GetChartTracksSpotify", params)
+	if f.GetChartTracksSpotifyFunc == nil {
+		return nil, notConfigured("GetChartTracksSpotify")
+	}
+
+	return f.GetChartTracksSpotifyFunc(ctx, params)
+}
+
+// GetChartArtistsSpotify records the call and delegates to GetChartArtistsSpotifyFunc.
+func (f *Fake) GetChartArtistsSpotify(ctx context.Context, params chartmetric.GetChartArtistSpotifyParams) ([]chartmetric.ChartArtistSpotify, error) {
+	f.record("GetChartArtistsSpotify", params)
+	if f.GetChartArtistsSpotifyFunc == nil {
+		return nil, notConfigured("GetChartArtistsSpotify")
+	}
+
+	return f.GetChartArtistsSpotifyFunc(ctx, params)
+}
+
+// GetChartEntriesTikTok records the call and delegates to GetChartEntriesTikTokFunc.
+func (f *Fake) GetChartEntriesTikTok(ctx context.Context, params chartmetric.GetChartEntriesTikTokParams) ([]chartmetric.ChartEntryTikTok, error) {
+	f.record("GetChartEntriesTikTok", params)
+	if f.GetChartEntriesTikTokFunc == nil {
+		return nil, notConfigured("GetChartEntriesTikTok")
+	}
+
+	return f.GetChartEntriesTikTokFunc(ctx, params)
+}
+
+// GetChartEntriesAppleMusic records the call and delegates to GetChartEntriesAppleMusicFunc.
+func (f *Fake) GetChartEntriesAppleMusic(ctx context.Context, params chartmetric.GetChartEntriesAppleMusicParams) ([]chartmetric.ChartEntryAppleMusic, error) {
+	f.record("GetChartEntriesAppleMusic", params)
+	if f.GetChartEntriesAppleMusicFunc == nil {
+		return nil, notConfigured("GetChartEntriesAppleMusic")
+	}
+
+	return f.GetChartEntriesAppleMusicFunc(ctx, params)
+}
+
+// GetChartEntriesAirplay records the call and delegates to GetChartEntriesAirplayFunc.
+func (f *Fake) GetChartEntriesAirplay(ctx context.Context, params chartmetric.GetChartEntriesAirplayParams) ([]chartmetric.ChartEntryAirplay, error) {
+	f.record("GetChartEntriesAirplay", params)
+	if f.GetChartEntriesAirplayFunc == nil {
+		return nil, notConfigured("GetChartEntriesAirplay")
+	}
+
+	return f.GetChartEntriesAirplayFunc(ctx, params)
+}
+
+// GetArtist records the call and delegates to GetArtistFunc.
+func (f *Fake) GetArtist(ctx context.Context, id int) (*chartmetric.Artist, error) {
+	f.record("GetArtist", id)
+	if f.GetArtistFunc == nil {
+		return nil, notConfigured("GetArtist")
+	}
+
+	return f.GetArtistFunc(ctx, id)
+}
+
+// GetArtistIDs records the call and delegates to GetArtistIDsFunc.
+func (f *Fake) GetArtistIDs(ctx context.Context, platform chartmetric.ArtistPlatform, id string) (*chartmetric.ArtistIDs, error) {
+	f.record("GetArtistIDs", platform, id)
+	if f.GetArtistIDsFunc == nil {
+		return nil, notConfigured("GetArtistIDs")
+	}
+
+	return f.GetArtistIDsFunc(ctx, platform, id)
+}
+
+// GetArtistStats records the call and delegates to GetArtistStatsFunc.
+func (f *Fake) GetArtistStats(ctx context.Context, id int, source chartmetric.ArtistStatSource, params *chartmetric.GetArtistStatsParams) (chartmetric.ArtistStats, error) {
+	f.record("GetArtistStats", id, source, params)
+	if f.GetArtistStatsFunc == nil {
+		return nil, notConfigured("GetArtistStats")
+	}
+
+	return f.GetArtistStatsFunc(ctx, id, source, params)
+}
+
+// GetArtistCharts records the call and delegates to GetArtistChartsFunc.
+func (f *Fake) GetArtistCharts(ctx context.Context, id int, chartType chartmetric.ArtistChartType, params chartmetric.GetArtistChartsParams) ([]chartmetric.ChartHistoryEntry, error) {
+	f.record("GetArtistCharts", id, chartType, params)
+	if f.GetArtistChartsFunc == nil {
+		return nil, notConfigured("GetArtistCharts")
+	}
+
+	return f.GetArtistChartsFunc(ctx, id, chartType, params)
+}
+
+// GetArtistPlaylists records the call and delegates to GetArtistPlaylistsFunc.
+func (f *Fake) GetArtistPlaylists(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error) {
+	f.record("GetArtistPlaylists", id, platform, status, params)
+	if f.GetArtistPlaylistsFunc == nil {
+		return nil, notConfigured("GetArtistPlaylists")
+	}
+
+	return f.GetArtistPlaylistsFunc(ctx, id, platform, status, params)
+}
+
+// GetArtistWhereListen records the call and delegates to GetArtistWhereListenFunc.
+func (f *Fake) GetArtistWhereListen(ctx context.Context, id int, params *chartmetric.GetArtistWhereListenParams) (*chartmetric.ListenerGeography, error) {
+	f.record("GetArtistWhereListen", id, params)
+	if f.GetArtistWhereListenFunc == nil {
+		return nil, notConfigured("GetArtistWhereListen")
+	}
+
+	return f.GetArtistWhereListenFunc(ctx, id, params)
+}
+
+// GetArtistAudienceGeography records the call and delegates to GetArtistAudienceGeographyFunc.
+func (f *Fake) GetArtistAudienceGeography(ctx context.Context, id int, platform chartmetric.AudiencePlatform, params *chartmetric.GetArtistAudienceParams) (*chartmetric.ListenerGeography, error) {
+	f.record("GetArtistAudienceGeography", id, platform, params)
+	if f.GetArtistAudienceGeographyFunc == nil {
+		return nil, notConfigured("GetArtistAudienceGeography")
+	}
+
+	return f.GetArtistAudienceGeographyFunc(ctx, id, platform, params)
+}
+
+// GetArtistAudienceStats records the call and delegates to GetArtistAudienceStatsFunc.
+func (f *Fake) GetArtistAudienceStats(ctx context.Context, id int, platform chartmetric.AudiencePlatform, params *chartmetric.GetArtistAudienceParams) (*chartmetric.AudienceStats, error) {
+	f.record("GetArtistAudienceStats", id, platform, params)
+	if f.GetArtistAudienceStatsFunc == nil {
+		return nil, notConfigured("GetArtistAudienceStats")
+	}
+
+	return f.GetArtistAudienceStatsFunc(ctx, id, platform, params)
+}
+
+// GetRelatedArtists records the call and delegates to GetRelatedArtistsFunc.
+func (f *Fake) GetRelatedArtists(ctx context.Context, id int, params *chartmetric.GetRelatedArtistsParams) ([]chartmetric.RelatedArtist, error) {
+	f.record("GetRelatedArtists", id, params)
+	if f.GetRelatedArtistsFunc == nil {
+		return nil, notConfigured("GetRelatedArtists")
+	}
+
+	return f.GetRelatedArtistsFunc(ctx, id, params)
+}
+
+// CrawlRelatedArtists records the call and delegates to CrawlRelatedArtistsFunc.
+func (f *Fake) CrawlRelatedArtists(ctx context.Context, seeds []int, params chartmetric.CrawlRelatedArtistsParams) (*chartmetric.ArtistGraph, error) {
+	f.record("CrawlRelatedArtists", seeds, params)
+	if f.CrawlRelatedArtistsFunc == nil {
+		return nil, notConfigured("CrawlRelatedArtists")
+	}
+
+	return f.CrawlRelatedArtistsFunc(ctx, seeds, params)
+}
+
+// ListArtistAlbums records the call and delegates to ListArtistAlbumsFunc.
+func (f *Fake) ListArtistAlbums(ctx context.Context, id int, params *chartmetric.ListArtistAlbumsParams) iter.Seq2[chartmetric.AlbumSummary, error] {
+	f.record("ListArtistAlbums", id, params)
+	if f.ListArtistAlbumsFunc == nil {
+		return notConfiguredSeq[chartmetric.AlbumSummary]("ListArtistAlbums")
+	}
+
+	return f.ListArtistAlbumsFunc(ctx, id, params)
+}
+
+// ListArtistTracks records the call and delegates to ListArtistTracksFunc.
+func (f *Fake) ListArtistTracks(ctx context.Context, id int, params *chartmetric.ListArtistTracksParams) iter.Seq2[chartmetric.TrackSummary, error] {
+	f.record("ListArtistTracks", id, params)
+	if f.ListArtistTracksFunc == nil {
+		return notConfiguredSeq[chartmetric.TrackSummary]("ListArtistTracks")
+	}
+
+	return f.ListArtistTracksFunc(ctx, id, params)
+}
+
+// GetArtistInsights records the call and delegates to GetArtistInsightsFunc.
+func (f *Fake) GetArtistInsights(ctx context.Context, id int, params *chartmetric.GetArtistInsightsParams) ([]chartmetric.ArtistInsight, error) {
+	f.record("GetArtistInsights", id, params)
+	if f.GetArtistInsightsFunc == nil {
+		return nil, notConfigured("GetArtistInsights")
+	}
+
+	return f.GetArtistInsightsFunc(ctx, id, params)
+}
+
+// GetArtistCareer records the call and delegates to GetArtistCareerFunc.
+func (f *Fake) GetArtistCareer(ctx context.Context, id int, params *chartmetric.GetArtistCareerParams) ([]chartmetric.ArtistCareerPoint, error) {
+	f.record("GetArtistCareer", id, params)
+	if f.GetArtistCareerFunc == nil {
+		return nil, notConfigured("GetArtistCareer")
+	}
+
+	return f.GetArtistCareerFunc(ctx, id, params)
+}
+
+// GetArtistTimeline records the call and delegates to GetArtistTimelineFunc.
+func (f *Fake) GetArtistTimeline(ctx context.Context, id int, params chartmetric.GetArtistTimelineParams) (chartmetric.ArtistTimeline, error) {
+	f.record("GetArtistTimeline", id, params)
+	if f.GetArtistTimelineFunc == nil {
+		return nil, notConfigured("GetArtistTimeline")
+	}
+
+	return f.GetArtistTimelineFunc(ctx, id, params)
+}
+
+// FilterArtists records the call and delegates to FilterArtistsFunc.
+func (f *Fake) FilterArtists(ctx context.Context, filter *chartmetric.ArtistFilter) iter.Seq2[chartmetric.FilteredArtist, error] {
+	f.record("FilterArtists", filter)
+	if f.FilterArtistsFunc == nil {
+		return notConfiguredSeq[chartmetric.FilteredArtist]("FilterArtists")
+	}
+
+	return f.FilterArtistsFunc(ctx, filter)
+}
+
+// GetArtistEvents records the call and delegates to GetArtistEventsFunc.
+func (f *Fake) GetArtistEvents(ctx context.Context, id int, status chartmetric.ArtistEventStatus) ([]chartmetric.ArtistEvent, error) {
+	f.record("GetArtistEvents", id, status)
+	if f.GetArtistEventsFunc == nil {
+		return nil, notConfigured("GetArtistEvents")
+	}
+
+	return f.GetArtistEventsFunc(ctx, id, status)
+}
+
+// GetArtistCMScore records the call and delegates to GetArtistCMScoreFunc.
+func (f *Fake) GetArtistCMScore(ctx context.Context, id int, params *chartmetric.GetArtistCMScoreParams) ([]chartmetric.StatPoint, error) {
+	f.record("GetArtistCMScore", id, params)
+	if f.GetArtistCMScoreFunc == nil {
+		return nil, notConfigured("GetArtistCMScore")
+	}
+
+	return f.GetArtistCMScoreFunc(ctx, id, params)
+}
+
+// GetArtistCMRank records the call and delegates to GetArtistCMRankFunc.
+func (f *Fake) GetArtistCMRank(ctx context.Context, id int, params chartmetric.GetArtistCMRankParams) ([]chartmetric.ArtistRankPoint, error) {
+	f.record("GetArtistCMRank", id, params)
+	if f.GetArtistCMRankFunc == nil {
+		return nil, notConfigured("GetArtistCMRank")
+	}
+
+	return f.GetArtistCMRankFunc(ctx, id, params)
+}
+
+// GetArtistURLs records the call and delegates to GetArtistURLsFunc.
+func (f *Fake) GetArtistURLs(ctx context.Context, id int) (chartmetric.ArtistURLs, error) {
+	f.record("GetArtistURLs", id)
+	if f.GetArtistURLsFunc == nil {
+		return nil, notConfigured("GetArtistURLs")
+	}
+
+	return f.GetArtistURLsFunc(ctx, id)
+}
+
+// ResolveArtistProfileURL records the call and delegates to ResolveArtistProfileURLFunc.
+func (f *Fake) ResolveArtistProfileURL(ctx context.Context, rawURL string) (*chartmetric.ArtistIDs, error) {
+	f.record("ResolveArtistProfileURL", rawURL)
+	if f.ResolveArtistProfileURLFunc == nil {
+		return nil, notConfigured("ResolveArtistProfileURL")
+	}
+
+	return f.ResolveArtistProfileURLFunc(ctx, rawURL)
+}
+
+// GetAlbum records the call and delegates to GetAlbumFunc.
+func (f *Fake) GetAlbum(ctx context.Context, id int) (*chartmetric.Album, error) {
+	f.record("GetAlbum", id)
+	if f.GetAlbumFunc == nil {
+		return nil, notConfigured("GetAlbum")
+	}
+
+	return f.GetAlbumFunc(ctx, id)
+}
+
+// GetAlbumTracks records the call and delegates to GetAlbumTracksFunc.
+func (f *Fake) GetAlbumTracks(ctx context.Context, id int) ([]chartmetric.TrackSummary, error) {
+	f.record("GetAlbumTracks", id)
+	if f.GetAlbumTracksFunc == nil {
+		return nil, notConfigured("GetAlbumTracks")
+	}
+
+	return f.GetAlbumTracksFunc(ctx, id)
+}
+
+// GetAlbumIDs records the call and delegates to GetAlbumIDsFunc.
+func (f *Fake) GetAlbumIDs(ctx context.Context, platform chartmetric.AlbumPlatform, id string) (*chartmetric.AlbumIDs, error) {
+	f.record("GetAlbumIDs", platform, id)
+	if f.GetAlbumIDsFunc == nil {
+		return nil, notConfigured("GetAlbumIDs")
+	}
+
+	return f.GetAlbumIDsFunc(ctx, platform, id)
+}
+
+// GetAlbumStats records the call and delegates to GetAlbumStatsFunc.
+func (f *Fake) GetAlbumStats(ctx context.Context, id int, source chartmetric.AlbumStatSource, params *chartmetric.GetAlbumStatsParams) (chartmetric.AlbumStats, error) {
+	f.record("GetAlbumStats", id, source, params)
+	if f.GetAlbumStatsFunc == nil {
+		return nil, notConfigured("GetAlbumStats")
+	}
+
+	return f.GetAlbumStatsFunc(ctx, id, source, params)
+}
+
+// GetAlbumCharts records the call and delegates to GetAlbumChartsFunc.
+func (f *Fake) GetAlbumCharts(ctx context.Context, id int, chartType chartmetric.AlbumChartType, params chartmetric.GetAlbumChartsParams) ([]chartmetric.ChartHistoryEntry, error) {
+	f.record("GetAlbumCharts", id, chartType, params)
+	if f.GetAlbumChartsFunc == nil {
+		return nil, notConfigured("GetAlbumCharts")
+	}
+
+	return f.GetAlbumChartsFunc(ctx, id, chartType, params)
+}
+
+// GetAlbumPlaylists records the call and delegates to GetAlbumPlaylistsFunc.
+func (f *Fake) GetAlbumPlaylists(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error) {
+	f.record("GetAlbumPlaylists", id, platform, status, params)
+	if f.GetAlbumPlaylistsFunc == nil {
+		return nil, notConfigured("GetAlbumPlaylists")
+	}
+
+	return f.GetAlbumPlaylistsFunc(ctx, id, platform, status, params)
+}
+
+// ListAlbumPlaylists records the call and delegates to ListAlbumPlaylistsFunc.
+func (f *Fake) ListAlbumPlaylists(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) iter.Seq2[chartmetric.PlaylistPlacement, error] {
+	f.record("ListAlbumPlaylists", id, platform, status, params)
+	if f.ListAlbumPlaylistsFunc == nil {
+		return notConfiguredSeq[chartmetric.PlaylistPlacement]("ListAlbumPlaylists")
+	}
+
+	return f.ListAlbumPlaylistsFunc(ctx, id, platform, status, params)
+}
+
+// GetTrack records the call and delegates to GetTrackFunc.
+func (f *Fake) GetTrack(ctx context.Context, id int) (*chartmetric.Track, error) {
+	f.record("GetTrack", id)
+	if f.GetTrackFunc == nil {
+		return nil, notConfigured("GetTrack")
+	}
+
+	return f.GetTrackFunc(ctx, id)
+}
+
+// GetTrackIDs records the call and delegates to GetTrackIDsFunc.
+func (f *Fake) GetTrackIDs(ctx context.Context, platform chartmetric.TrackPlatform, id string) (*chartmetric.TrackIDs, error) {
+	f.record("GetTrackIDs", platform, id)
+	if f.GetTrackIDsFunc == nil {
+		return nil, notConfigured("GetTrackIDs")
+	}
+
+	return f.GetTrackIDsFunc(ctx, platform, id)
+}
+
+// ResolveTrackIDs records the call and delegates to ResolveTrackIDsFunc.
+// When ResolveTrackIDsFunc is nil, every ID is returned with ErrNotConfigured.
+func (f *Fake) ResolveTrackIDs(ctx context.Context, platform chartmetric.TrackPlatform, ids []string, opts *chartmetric.ResolveTrackIDsOptions) []chartmetric.TrackIDsResult {
+	f.record("ResolveTrackIDs", platform, ids, opts)
+	if f.ResolveTrackIDsFunc == nil {
+		results := make([]chartmetric.TrackIDsResult, len(ids))
+		for i, id := range ids {
+			results[i] = chartmetric.TrackIDsResult{ID: id, Err: notConfigured("ResolveTrackIDs")}
+		}
+		return results
+	}
+
+	return f.ResolveTrackIDsFunc(ctx, platform, ids, opts)
+}
+
+// GetTrackStats records the call and delegates to GetTrackStatsFunc.
+func (f *Fake) GetTrackStats(ctx context.Context, id int, platform chartmetric.TrackStatPlatform, params *chartmetric.GetTrackStatsParams) ([]chartmetric.TrackStatSeries, error) {
+	f.record("GetTrackStats", id, platform, params)
+	if f.GetTrackStatsFunc == nil {
+		return nil, notConfigured("GetTrackStats")
+	}
+
+	return f.GetTrackStatsFunc(ctx, id, platform, params)
+}
+
+// GetTrackCharts records the call and delegates to GetTrackChartsFunc.
+func (f *Fake) GetTrackCharts(ctx context.Context, id int, chartType chartmetric.TrackChartType, params chartmetric.GetTrackChartsParams) ([]chartmetric.ChartHistoryEntry, error) {
+	f.record("GetTrackCharts", id, chartType, params)
+	if f.GetTrackChartsFunc == nil {
+		return nil, notConfigured("GetTrackCharts")
+	}
+
+	return f.GetTrackChartsFunc(ctx, id, chartType, params)
+}
+
+// GetTrackPlaylists records the call and delegates to GetTrackPlaylistsFunc.
+func (f *Fake) GetTrackPlaylists(ctx context.Context, id int, platform chartmetric.PlaylistPlatform, status chartmetric.PlaylistStatus, params *chartmetric.GetPlaylistPlacementsParams) ([]chartmetric.PlaylistPlacement, error) {
+	f.record("GetTrackPlaylists", id, platform, status, params)
+	if f.GetTrackPlaylistsFunc == nil {
+		return nil, notConfigured("GetTrackPlaylists")
+	}
+
+	return f.GetTrackPlaylistsFunc(ctx, id, platform, status, params)
+}
+
+// Search records the call and delegates to SearchFunc.
+func (f *Fake) Search(ctx context.Context, query string, params *chartmetric.SearchParams) (*chartmetric.SearchResults, error) {
+	f.record("Search", query, params)
+	if f.SearchFunc == nil {
+		return nil, notConfigured("Search")
+	}
+
+	return f.SearchFunc(ctx, query, params)
+}
+
+// ResolveArtist records the call and delegates to ResolveArtistFunc.
+func (f *Fake) ResolveArtist(ctx context.Context, name string, hints *chartmetric.ResolveArtistHints) (*chartmetric.ArtistMatch, error) {
+	f.record("ResolveArtist", name, hints)
+	if f.ResolveArtistFunc == nil {
+		return nil, notConfigured("ResolveArtist")
+	}
+
+	return f.ResolveArtistFunc(ctx, name, hints)
+}
@@ -0,0 +1,35 @@
+package chartmetrictest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/chartmetrictest"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Fake(t *testing.T) {
+	fake := &chartmetrictest.Fake{
+		GetTrackIDsFunc: func(ctx context.Context, platform chartmetric.TrackPlatform, id string) (*chartmetric.TrackIDs, error) {
+			return &chartmetric.TrackIDs{ISRC: "USUM71703861", SpotifyIDs: []string{id}}, nil
+		},
+	}
+
+	var api chartmetric.API = fake
+
+	trackIDs, err := api.GetTrackIDs(context.Background(), chartmetric.TrackPlatformSpotify, "5KSJ9k1FYjFLnIRlJT2wF8")
+	assert.NoError(t, err)
+	assert.Equal(t, "USUM71703861", trackIDs.ISRC)
+
+	_, err = api.GetChartCountries(context.Background(), chartmetric.ChartPlatformSpotify, nil)
+	assert.ErrorIs(t, err, chartmetrictest.ErrNotConfigured)
+
+	calls := fake.CallsTo("GetTrackIDs")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, []any{chartmetric.TrackPlatformSpotify, "5KSJ9k1FYjFLnIRlJT2wF8"}, calls[0].Args)
+	assert.Len(t, fake.Calls(), 2)
+
+	fake.Reset()
+	assert.Empty(t, fake.Calls())
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -12,8 +13,9 @@ import (
 )
 
 const (
-	defaultRetryAttempts = 3
-	defaultRetryDelay    = 700 * time.Millisecond
+	defaultRetryAttempts     = 3
+	defaultRetryDelay        = 700 * time.Millisecond
+	defaultTokenExpiryMargin = 5 * time.Second
 )
 
 var (
@@ -24,13 +26,17 @@ var (
 // Client is the Chartmetric API client.
 // It handles authentication, rate limiting, and making HTTP requests to the API.
 type Client struct {
-	refreshToken  string
-	accessToken   *accessToken
-	httpClient    *http.Client
-	baseURL       string
-	rateLimiter   *rate.Limiter
-	retryAttempts uint
-	retryDelay    time.Duration
+	refreshToken      string
+	accessToken       *accessToken
+	accessTokenMu     sync.Mutex
+	tokenExpiryMargin time.Duration
+	httpClient        *http.Client
+	baseURL           string
+	rateLimiter       *rate.Limiter
+	retryAttempts     uint
+	retryDelay        time.Duration
+	clock             Clock
+	faultConfig       *FaultConfig
 }
 
 type ClientOption func(*Client)
@@ -43,16 +49,20 @@ func NewClient(refreshToken string, options ...ClientOption) *Client {
 		httpClient: &http.Client{
 			Timeout: time.Duration(10) * time.Second,
 		},
-		baseURL:       "https://api.chartmetric.com/api",
-		rateLimiter:   rate.NewLimiter(rate.Limit(1), 1),
-		retryAttempts: defaultRetryAttempts,
-		retryDelay:    defaultRetryDelay,
+		baseURL:           "https://api.chartmetric.com/api",
+		rateLimiter:       rate.NewLimiter(rate.Limit(1), 1),
+		retryAttempts:     defaultRetryAttempts,
+		retryDelay:        defaultRetryDelay,
+		tokenExpiryMargin: defaultTokenExpiryMargin,
+		clock:             systemClock{},
 	}
 
 	for _, option := range options {
 		option(client)
 	}
 
+	client.withFaultInjection()
+
 	return client
 }
 
@@ -95,6 +105,22 @@ func WithRetryDelay(retryDelay time.Duration) ClientOption {
 	}
 }
 
+// WithClock allows setting a custom clock, used for token expiry, retry delays and rate limiting.
+// This is mostly useful for tests (see chartmetrictest.FakeClock).
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) {
+		c.clock = clock
+	}
+}
+
+// WithTokenExpiryMargin allows setting how long before its actual expiry an access token is considered expired.
+// The default is 5 seconds.
+func WithTokenExpiryMargin(margin time.Duration) ClientOption {
+	return func(c *Client) {
+		c.tokenExpiryMargin = margin
+	}
+}
+
 // GetAny is a generic GET request method that can be used to fetch any data from the API.
 // This could be useful for testing. For actual API calls, consider using the specific methods provided by the Client.
 func (c *Client) GetAny(ctx context.Context, path string, queryParams map[string]any) ([]byte, error) {
@@ -111,13 +137,20 @@ func (c *Client) requestWithRetry(ctx context.Context, httpMethod, path string,
 		func() ([]byte, error) {
 			return c.request(ctx, httpMethod, path, queryParams, body)
 		},
+		c.retryOptions(ctx)...,
+	)
+}
+
+func (c *Client) retryOptions(ctx context.Context) []retry.Option {
+	return []retry.Option{
 		retry.Context(ctx),
 		retry.Attempts(c.retryAttempts),
 		retry.Delay(c.retryDelay),
+		retry.WithTimer(c.clock),
 		retry.RetryIf(func(err error) bool {
 			return errors.Is(err, errRateLimitExceeded) || errors.Is(err, errTemporarilyUnavailable)
 		}),
-	)
+	}
 }
 
 func (c *Client) request(ctx context.Context, httpMethod, path string, queryParams map[string]any, body any) ([]byte, error) {
@@ -140,7 +173,7 @@ func (c *Client) request(ctx context.Context, httpMethod, path string, queryPara
 
 	addQueryParams(req, queryParams)
 
-	if err := c.rateLimiter.Wait(ctx); err != nil {
+	if err := c.waitRateLimit(ctx); err != nil {
 		return nil, fmt.Errorf("rate limiter wait: %w", err)
 	}
 
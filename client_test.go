@@ -5,9 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/chartmetrictest"
 	"github.com/musicx-fm/chartmetric-go-client/testdata"
 	"github.com/stretchr/testify/assert"
 )
@@ -39,6 +42,118 @@ func Test_Client_GetAny(t *testing.T) {
 	assert.Equal(t, testdata.GenresResponse, string(responseData))
 }
 
+func Test_Client_TokenExpiry(t *testing.T) {
+	var tokenRequests atomic.Int32
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": func(w http.ResponseWriter, r *http.Request) {
+			tokenRequests.Add(1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TokenResponse))
+		},
+		"GET /genres": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.GenresResponse))
+		},
+	})
+	defer ts.Close()
+
+	clock := chartmetrictest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	client := chartmetric.NewClient(
+		"test-refresh-token",
+		chartmetric.WithBaseURL(ts.URL),
+		chartmetric.WithClock(clock),
+		chartmetric.WithTokenExpiryMargin(time.Minute),
+	)
+
+	_, err := client.GetAny(context.Background(), "/genres", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), tokenRequests.Load())
+
+	// token expires in 3600 seconds, minus the 1 minute margin
+	clock.Advance(59 * time.Minute)
+	_, err = client.GetAny(context.Background(), "/genres", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), tokenRequests.Load())
+
+	clock.Advance(time.Second)
+	_, err = client.GetAny(context.Background(), "/genres", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), tokenRequests.Load())
+}
+
+func Test_Client_FaultInjection(t *testing.T) {
+	var genresRequests atomic.Int32
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TokenResponse))
+		},
+		"GET /genres": func(w http.ResponseWriter, r *http.Request) {
+			genresRequests.Add(1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.GenresResponse))
+		},
+		"GET /charts/spotify/countries": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.ChartCountriesResponse))
+		},
+	})
+	defer ts.Close()
+
+	t.Run("rate limit is retried", func(t *testing.T) {
+		client := chartmetric.NewClient(
+			"test-refresh-token",
+			chartmetric.WithBaseURL(ts.URL),
+			chartmetric.WithRetryAttempts(2),
+			chartmetric.WithRetryDelay(time.Millisecond),
+			chartmetric.WithRateLimitPerSec(100),
+			chartmetric.WithFaultInjection(chartmetric.FaultConfig{Seed: 1, RateLimitProbability: 1}),
+		)
+
+		_, err := client.GetAny(context.Background(), "/genres", nil)
+		assert.ErrorContains(t, err, "All attempts fail")
+		assert.ErrorContains(t, err, "rate limit exceeded")
+		assert.Equal(t, int32(0), genresRequests.Load())
+	})
+
+	t.Run("malformed json", func(t *testing.T) {
+		client := chartmetric.NewClient(
+			"test-refresh-token",
+			chartmetric.WithBaseURL(ts.URL),
+			chartmetric.WithFaultInjection(chartmetric.FaultConfig{Seed: 1, MalformedJSONProbability: 1}),
+		)
+
+		_, err := client.GetChartCountries(context.Background(), chartmetric.ChartPlatformSpotify, nil)
+		assert.ErrorContains(t, err, "json unmarshal")
+		assert.NotContains(t, err.Error(), "resolve access token")
+	})
+
+	t.Run("token failure", func(t *testing.T) {
+		client := chartmetric.NewClient(
+			"test-refresh-token",
+			chartmetric.WithBaseURL(ts.URL),
+			chartmetric.WithFaultInjection(chartmetric.FaultConfig{Seed: 1, TokenFailureProbability: 1}),
+		)
+
+		_, err := client.GetAny(context.Background(), "/genres", nil)
+		assert.ErrorContains(t, err, "resolve access token")
+		assert.ErrorContains(t, err, "[401]")
+	})
+}
+
+func tokenHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(testdata.TokenResponse))
+}
+
 func chartmetricTestServer(handlers map[string]http.HandlerFunc) *httptest.Server {
 	mux := http.NewServeMux()
 	for path, handler := range handlers {
@@ -0,0 +1,47 @@
+package chartmetric
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Clock is the source of time used by the Client for token expiry, retry delays and rate limiting.
+// It is satisfied by the system clock by default; see WithClock.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// waitRateLimit blocks until the rate limiter permits a request, measuring time with the Client's clock.
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	now := c.clock.Now()
+
+	reservation := c.rateLimiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return errors.New("rate limiter does not allow any requests")
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-c.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		reservation.CancelAt(c.clock.Now())
+		return ctx.Err()
+	}
+}
@@ -1,5 +1,11 @@
 package chartmetric
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
 // Optional is a pointer to a value of type T.
 type Optional[T any] *T
 
@@ -7,3 +13,47 @@ type Optional[T any] *T
 func Opt[T any](v T) Optional[T] {
 	return &v
 }
+
+// StatPoint is a single data point of a time series.
+type StatPoint struct {
+	Value        float64 `json:"value"`
+	Timestamp    Date    `json:"timestp"`
+	Diff         float64 `json:"diff"`
+	Interpolated bool    `json:"interpolated"`
+}
+
+// latestStatPoint returns the most recent data point of a time series, if there is any.
+func latestStatPoint(points []StatPoint) (StatPoint, bool) {
+	if len(points) == 0 {
+		return StatPoint{}, false
+	}
+
+	latest := points[0]
+	for _, point := range points[1:] {
+		if point.Timestamp.After(latest.Timestamp.Time) {
+			latest = point
+		}
+	}
+
+	return latest, true
+}
+
+// decodeStatSeries decodes the time series of a stats response, keyed by metric.
+// Besides the series, the response can contain scalar fields, which are skipped.
+// A series that fails to decode is an error, rather than a silently missing metric.
+func decodeStatSeries[K ~string](raw map[K]json.RawMessage) (map[K][]StatPoint, error) {
+	stats := make(map[K][]StatPoint, len(raw))
+	for metric, rawSeries := range raw {
+		if trimmed := bytes.TrimSpace(rawSeries); len(trimmed) == 0 || trimmed[0] != '[' {
+			continue
+		}
+
+		var series []StatPoint
+		if err := json.Unmarshal(rawSeries, &series); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+		stats[metric] = series
+	}
+
+	return stats, nil
+}
@@ -19,7 +19,7 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 		return nil
 	}
 
-	parsed, err := time.Parse(DateFormat, s)
+	parsed, err := parseDate(s)
 	if err != nil {
 		return fmt.Errorf("parse date: %w", err)
 	}
@@ -33,6 +33,42 @@ func (d Date) MarshalJSON() ([]byte, error) {
 	return []byte(d.String()), nil
 }
 
+// UnmarshalText allows Date to be used as a JSON object key.
+func (d *Date) UnmarshalText(b []byte) error {
+	parsed, err := parseDate(string(b))
+	if err != nil {
+		return fmt.Errorf("parse date: %w", err)
+	}
+
+	d.Time = parsed
+
+	return nil
+}
+
+// MarshalText allows Date to be used as a JSON object key.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.Time.Format(DateFormat)), nil
+}
+
 func (d *Date) String() string {
 	return fmt.Sprintf("%q", d.Time.Format(DateFormat))
 }
+
+// parseDate parses a YYYY-MM-DD date. Full RFC 3339 timestamps, which some endpoints return
+// for daily data points, are also accepted and truncated to their date.
+func parseDate(s string) (time.Time, error) {
+	if len(s) > len(DateFormat) {
+		parsed, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return truncateToDate(parsed), nil
+	}
+
+	return time.Parse(DateFormat, s)
+}
+
+// truncateToDate drops the time of day of t, returning midnight UTC of t's date.
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,159 @@
+package chartmetric
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FaultConfig configures the fault injector enabled by WithFaultInjection.
+// Probabilities are in the range [0, 1] and are rolled independently for every request.
+type FaultConfig struct {
+	// Seed seeds the random source, so that a sequence of failures can be reproduced.
+	Seed int64
+
+	// RateLimitProbability is the probability of responding with 429 Too Many Requests.
+	RateLimitProbability float64
+	// UnavailableProbability is the probability of responding with 503 Service Unavailable.
+	UnavailableProbability float64
+	// ServerErrorProbability is the probability of responding with 500 Internal Server Error.
+	ServerErrorProbability float64
+
+	// LatencyProbability is the probability of delaying a request by Latency before it is sent.
+	LatencyProbability float64
+	Latency            time.Duration
+
+	// TruncatedBodyProbability is the probability of cutting a successful response body in half.
+	TruncatedBodyProbability float64
+	// MalformedJSONProbability is the probability of replacing a successful response body with invalid JSON.
+	MalformedJSONProbability float64
+
+	// TokenFailureProbability is the probability of the token endpoint responding with 401 Unauthorized.
+	// All other faults, apart from latency, are only injected into API requests, not token requests.
+	TokenFailureProbability float64
+}
+
+// WithFaultInjection enables an opt-in fault injector placed in front of the HTTP client's transport.
+// It is meant for chaos testing code that consumes the Client, and should never be enabled in production.
+// Injected 429 and 503 responses go through the same retry logic as real ones.
+func WithFaultInjection(config FaultConfig) ClientOption {
+	return func(c *Client) {
+		c.faultConfig = &config
+	}
+}
+
+func (c *Client) withFaultInjection() {
+	if c.faultConfig == nil {
+		return
+	}
+
+	httpClient := *c.httpClient
+	httpClient.Transport = &faultTransport{
+		next:   httpClient.Transport,
+		config: *c.faultConfig,
+		clock:  c.clock,
+		rand:   rand.New(rand.NewSource(c.faultConfig.Seed)),
+	}
+	c.httpClient = &httpClient
+}
+
+type faultTransport struct {
+	next   http.RoundTripper
+	config FaultConfig
+	clock  Clock
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.roll(t.config.LatencyProbability) {
+		select {
+		case <-t.clock.After(t.config.Latency):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if strings.HasSuffix(req.URL.Path, "/token") {
+		if t.roll(t.config.TokenFailureProbability) {
+			return injectedResponse(req, http.StatusUnauthorized, `{"error":"injected token failure"}`), nil
+		}
+
+		return next.RoundTrip(req)
+	}
+
+	if t.roll(t.config.RateLimitProbability) {
+		return injectedResponse(req, http.StatusTooManyRequests, `{"error":"injected rate limit"}`), nil
+	}
+	if t.roll(t.config.UnavailableProbability) {
+		return injectedResponse(req, http.StatusServiceUnavailable, `{"error":"injected unavailability"}`), nil
+	}
+	if t.roll(t.config.ServerErrorProbability) {
+		return injectedResponse(req, http.StatusInternalServerError, `{"error":"injected server error"}`), nil
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || !isStatusSuccess(resp) {
+		return resp, err
+	}
+
+	truncate := t.roll(t.config.TruncatedBodyProbability)
+	malform := t.roll(t.config.MalformedJSONProbability)
+	if !truncate && !malform {
+		return resp, nil
+	}
+
+	bodyBytes, err := readResponseBody(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	switch {
+	case malform:
+		bodyBytes = []byte(`{"obj": [{"injected": malformed`)
+	case truncate:
+		bodyBytes = bodyBytes[:len(bodyBytes)/2]
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	resp.ContentLength = int64(len(bodyBytes))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
+
+func (t *faultTransport) roll(probability float64) bool {
+	if probability <= 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.rand.Float64() < probability
+}
+
+func injectedResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
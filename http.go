@@ -44,6 +44,13 @@ func addQueryParams(req *http.Request, params map[string]any) {
 
 	q := req.URL.Query()
 	for key, val := range params {
+		// list params are sent as repeated keys
+		if vals, ok := val.([]string); ok {
+			for _, v := range vals {
+				q.Add(key, v)
+			}
+			continue
+		}
 		q.Add(key, fmt.Sprintf("%v", val))
 	}
 
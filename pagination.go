@@ -0,0 +1,42 @@
+package chartmetric
+
+import (
+	"context"
+	"iter"
+)
+
+const defaultPageSize = 100
+
+// paginate returns an iterator over the items of successive pages fetched with fetchPage,
+// stopping after the first page that comes back shorter than pageSize.
+// An error ends the iteration, after being yielded along with the zero value of T.
+func paginate[T any](ctx context.Context, pageSize int, fetchPage func(ctx context.Context, offset, limit int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for offset := 0; ; offset += pageSize {
+			page, err := fetchPage(ctx, offset, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(page) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+func pageSizeOrDefault(pageSize Optional[int]) int {
+	if pageSize == nil || *pageSize <= 0 {
+		return defaultPageSize
+	}
+
+	return *pageSize
+}
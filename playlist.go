@@ -0,0 +1,210 @@
+package chartmetric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+)
+
+type PlaylistPlatform string
+
+const (
+	PlaylistPlatformAmazon     PlaylistPlatform = "amazon"
+	PlaylistPlatformAppleMusic PlaylistPlatform = "applemusic"
+	PlaylistPlatformDeezer     PlaylistPlatform = "deezer"
+	PlaylistPlatformSpotify    PlaylistPlatform = "spotify"
+	PlaylistPlatformYouTube    PlaylistPlatform = "youtube"
+)
+
+type PlaylistStatus string
+
+const (
+	PlaylistStatusCurrent PlaylistStatus = "current"
+	PlaylistStatusPast    PlaylistStatus = "past"
+)
+
+type PlaylistSortColumn string
+
+const (
+	PlaylistSortColumnAddedAt      PlaylistSortColumn = "added_at"
+	PlaylistSortColumnFollowers    PlaylistSortColumn = "followers"
+	PlaylistSortColumnPeakPosition PlaylistSortColumn = "peak_position"
+	PlaylistSortColumnPosition     PlaylistSortColumn = "position"
+	PlaylistSortColumnRemovedAt    PlaylistSortColumn = "removed_at"
+)
+
+// GetPlaylistPlacementsParams are the params shared by the artist, album and track playlist placement endpoints.
+type GetPlaylistPlacementsParams struct {
+	Since        Optional[time.Time]
+	Until        Optional[time.Time]
+	Limit        Optional[int]
+	Offset       Optional[int]
+	SortColumn   Optional[PlaylistSortColumn]
+	Editorial    Optional[bool]
+	Personalized Optional[bool]
+	MajorCurator Optional[bool]
+	Chart        Optional[bool]
+	Indie        Optional[bool]
+}
+
+type getPlaylistPlacementsResponse struct {
+	Obj []PlaylistPlacement `json:"obj"`
+}
+
+// PlaylistPlacement is a track's placement on a playlist.
+type PlaylistPlacement struct {
+	Playlist PlacementPlaylist `json:"playlist"`
+	Track    PlacementTrack    `json:"track"`
+}
+
+type PlacementPlaylist struct {
+	ID                 int       `json:"id"`
+	PlatformPlaylistID string    `json:"playlist_id"`
+	Name               string    `json:"name"`
+	ImageURL           string    `json:"image_url"`
+	OwnerName          string    `json:"owner_name"`
+	CuratorID          int       `json:"curator_id"`
+	Followers          int       `json:"followers"`
+	Editorial          bool      `json:"editorial"`
+	Personalized       bool      `json:"personalized"`
+	MajorCurator       bool      `json:"major_curator"`
+	Chart              bool      `json:"chart"`
+	CountryCode        string    `json:"code2"`
+	Position           int       `json:"position"`
+	PeakPosition       int       `json:"peak_position"`
+	AddedAt            time.Time `json:"added_at"`
+	RemovedAt          time.Time `json:"removed_at"`
+	Period             int       `json:"period"`
+}
+
+type PlacementTrack struct {
+	ChartmetricTrackID   int      `json:"cm_track"`
+	Name                 string   `json:"name"`
+	ISRC                 string   `json:"isrc"`
+	ChartmetricArtistIDs []int    `json:"cm_artist"`
+	ArtistNames          []string `json:"artist_names"`
+	ChartmetricAlbumIDs  []int    `json:"album_ids"`
+	AlbumNames           []string `json:"album_names"`
+	ReleaseDates         []Date   `json:"release_dates"`
+}
+
+func (c *Client) getPlaylistPlacements(ctx context.Context, path string, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error) {
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+		if params.Offset != nil {
+			queryParams["offset"] = *params.Offset
+		}
+		if params.SortColumn != nil {
+			queryParams["sortColumn"] = *params.SortColumn
+		}
+		if params.Editorial != nil {
+			queryParams["editorial"] = *params.Editorial
+		}
+		if params.Personalized != nil {
+			queryParams["personalized"] = *params.Personalized
+		}
+		if params.MajorCurator != nil {
+			queryParams["majorCurator"] = *params.MajorCurator
+		}
+		if params.Chart != nil {
+			queryParams["chart"] = *params.Chart
+		}
+		if params.Indie != nil {
+			queryParams["indie"] = *params.Indie
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getPlaylistPlacementsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// listPlaylistPlacements returns an iterator over all the playlist placements at path, fetching them page by page.
+// params.Limit is used as the page size (defaults to 100) and params.Offset is ignored.
+func (c *Client) listPlaylistPlacements(ctx context.Context, path string, params *GetPlaylistPlacementsParams) iter.Seq2[PlaylistPlacement, error] {
+	var baseParams GetPlaylistPlacementsParams
+	if params != nil {
+		baseParams = *params
+	}
+
+	return paginate(ctx, pageSizeOrDefault(baseParams.Limit), func(ctx context.Context, offset, limit int) ([]PlaylistPlacement, error) {
+		pageParams := baseParams
+		pageParams.Offset = Opt(offset)
+		pageParams.Limit = Opt(limit)
+
+		return c.getPlaylistPlacements(ctx, path, &pageParams)
+	})
+}
+
+// ==================================================
+
+type PlacementChangeKind string
+
+const (
+	PlacementChangeAdded   PlacementChangeKind = "added"
+	PlacementChangeRemoved PlacementChangeKind = "removed"
+)
+
+// PlacementChange is a placement that was added or removed between two snapshots.
+type PlacementChange struct {
+	Kind      PlacementChangeKind
+	Placement PlaylistPlacement
+}
+
+// DiffPlaylistPlacements compares two snapshots of playlist placements (e.g. two calls to GetTrackPlaylists
+// with PlaylistStatusCurrent) and returns the placements added since the previous snapshot, in the order
+// of current, followed by the ones removed, in the order of previous.
+// Placements are matched by playlist and track.
+func DiffPlaylistPlacements(previous, current []PlaylistPlacement) []PlacementChange {
+	type placementKey struct {
+		playlistID int
+		trackID    int
+	}
+	keyOf := func(placement PlaylistPlacement) placementKey {
+		return placementKey{placement.Playlist.ID, placement.Track.ChartmetricTrackID}
+	}
+
+	previousKeys := make(map[placementKey]bool, len(previous))
+	for _, placement := range previous {
+		previousKeys[keyOf(placement)] = true
+	}
+	currentKeys := make(map[placementKey]bool, len(current))
+	for _, placement := range current {
+		currentKeys[keyOf(placement)] = true
+	}
+
+	var changes []PlacementChange
+	for _, placement := range current {
+		if !previousKeys[keyOf(placement)] {
+			changes = append(changes, PlacementChange{Kind: PlacementChangeAdded, Placement: placement})
+		}
+	}
+	for _, placement := range previous {
+		if !currentKeys[keyOf(placement)] {
+			changes = append(changes, PlacementChange{Kind: PlacementChangeRemoved, Placement: placement})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,30 @@
+package chartmetric_test
+
+import (
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffPlaylistPlacements(t *testing.T) {
+	placement := func(playlistID, trackID int) chartmetric.PlaylistPlacement {
+		return chartmetric.PlaylistPlacement{
+			Playlist: chartmetric.PlacementPlaylist{ID: playlistID},
+			Track:    chartmetric.PlacementTrack{ChartmetricTrackID: trackID},
+		}
+	}
+
+	previous := []chartmetric.PlaylistPlacement{placement(1, 100), placement(2, 100), placement(3, 100)}
+	current := []chartmetric.PlaylistPlacement{placement(2, 100), placement(4, 100), placement(3, 200)}
+
+	changes := chartmetric.DiffPlaylistPlacements(previous, current)
+	require.Len(t, changes, 4)
+	assert.Equal(t, chartmetric.PlacementChange{Kind: chartmetric.PlacementChangeAdded, Placement: placement(4, 100)}, changes[0])
+	assert.Equal(t, chartmetric.PlacementChange{Kind: chartmetric.PlacementChangeAdded, Placement: placement(3, 200)}, changes[1])
+	assert.Equal(t, chartmetric.PlacementChange{Kind: chartmetric.PlacementChangeRemoved, Placement: placement(1, 100)}, changes[2])
+	assert.Equal(t, chartmetric.PlacementChange{Kind: chartmetric.PlacementChangeRemoved, Placement: placement(3, 100)}, changes[3])
+
+	assert.Empty(t, chartmetric.DiffPlaylistPlacements(current, current))
+}
@@ -0,0 +1,294 @@
+package chartmetric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+type SearchType string
+
+const (
+	SearchTypeAll         SearchType = "all"
+	SearchTypeAlbums      SearchType = "albums"
+	SearchTypeArtists     SearchType = "artists"
+	SearchTypeCities      SearchType = "cities"
+	SearchTypeCurators    SearchType = "curators"
+	SearchTypeLabels      SearchType = "labels"
+	SearchTypePlaylists   SearchType = "playlists"
+	SearchTypeSongwriters SearchType = "songwriters"
+	SearchTypeStations    SearchType = "stations"
+	SearchTypeTracks      SearchType = "tracks"
+)
+
+type SearchParams struct {
+	Type   Optional[SearchType]
+	Limit  Optional[int]
+	Offset Optional[int]
+}
+
+type searchResponse struct {
+	Obj SearchResults `json:"obj"`
+}
+
+type SearchResults struct {
+	Artists     []SearchArtist                        `json:"artists"`
+	Tracks      []SearchTrack                         `json:"tracks"`
+	Albums      []SearchAlbum                         `json:"albums"`
+	Playlists   map[PlaylistPlatform][]SearchPlaylist `json:"playlists"`
+	Curators    map[PlaylistPlatform][]SearchCurator  `json:"curators"`
+	Stations    []SearchStation                       `json:"stations"`
+	Cities      []SearchCity                          `json:"cities"`
+	Labels      []SearchLabel                         `json:"labels"`
+	Songwriters []SearchSongwriter                    `json:"songwriters"`
+}
+
+type SearchArtist struct {
+	ID                      int      `json:"id"`
+	Name                    string   `json:"name"`
+	ImageURL                string   `json:"image_url"`
+	CountryCode             string   `json:"code2"`
+	Tags                    []string `json:"tags"`
+	Verified                bool     `json:"verified"`
+	SpotifyFollowers        int      `json:"sp_followers"`
+	SpotifyMonthlyListeners int      `json:"sp_monthly_listeners"`
+	ChartmetricArtistScore  float64  `json:"cm_artist_score"`
+}
+
+type SearchTrack struct {
+	ID                   int      `json:"id"`
+	Name                 string   `json:"name"`
+	ISRC                 string   `json:"isrc"`
+	ImageURL             string   `json:"image_url"`
+	ChartmetricArtistIDs []int    `json:"cm_artist"`
+	ArtistNames          []string `json:"artist_names"`
+}
+
+type SearchAlbum struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	ImageURL    string   `json:"image_url"`
+	UPC         string   `json:"upc"`
+	Label       string   `json:"label"`
+	ReleaseDate Date     `json:"release_date"`
+	ArtistNames []string `json:"artist_names"`
+}
+
+type SearchPlaylist struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	ImageURL  string `json:"image_url"`
+	OwnerName string `json:"owner_name"`
+	Followers int    `json:"followers"`
+}
+
+type SearchCurator struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	ImageURL  string `json:"image_url"`
+	Followers int    `json:"followers"`
+}
+
+type SearchStation struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	City        string `json:"city"`
+	CountryCode string `json:"code2"`
+}
+
+type SearchCity struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Province    string `json:"province"`
+	CountryCode string `json:"code2"`
+}
+
+type SearchLabel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type SearchSongwriter struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Search searches Chartmetric entities by name or URL.
+// See https://api.chartmetric.com/apidoc/#api-Search-Search.
+func (c *Client) Search(ctx context.Context, query string, params *SearchParams) (*SearchResults, error) {
+	path := "/search"
+
+	queryParams := make(map[string]any)
+	queryParams["q"] = query
+	if params != nil {
+		if params.Type != nil {
+			queryParams["type"] = *params.Type
+		}
+		if params.Limit != nil {
+			queryParams["limit"] = *params.Limit
+		}
+		if params.Offset != nil {
+			queryParams["offset"] = *params.Offset
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response searchResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return &response.Obj, nil
+}
+
+// ==================================================
+
+const (
+	resolveArtistCandidates = 10
+
+	resolveWeightName      = 0.6
+	resolveWeightCountry   = 0.15
+	resolveWeightGenre     = 0.1
+	resolveWeightFollowers = 0.15
+)
+
+// ResolveArtistHints narrow down the candidates considered by ResolveArtist. All hints are optional,
+// and empty ones are ignored.
+type ResolveArtistHints struct {
+	CountryCode Optional[string]
+	Genre       Optional[string]
+	// SpotifyFollowers is the expected order of magnitude of the artist's Spotify followers.
+	// Without it, more followed artists are slightly preferred.
+	SpotifyFollowers Optional[int]
+}
+
+type ArtistMatch struct {
+	Artist SearchArtist
+	// Confidence is between 0 and 1, 1 being an exact name match that agrees with every hint.
+	// Without a SpotifyFollowers hint, part of the confidence comes from the artist's popularity instead,
+	// so only artists with ~100M Spotify followers reach 1.
+	Confidence float64
+}
+
+// ResolveArtist searches artists by name and returns the candidate that best matches the name and hints.
+func (c *Client) ResolveArtist(ctx context.Context, name string, hints *ResolveArtistHints) (*ArtistMatch, error) {
+	results, err := c.Search(ctx, name, &SearchParams{
+		Type:  Opt(SearchTypeArtists),
+		Limit: Opt(resolveArtistCandidates),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	if hints == nil {
+		hints = &ResolveArtistHints{}
+	}
+
+	var best *ArtistMatch
+	for _, candidate := range results.Artists {
+		confidence := scoreArtistCandidate(name, candidate, hints)
+		if best == nil || confidence > best.Confidence {
+			best = &ArtistMatch{Artist: candidate, Confidence: confidence}
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no artist found for name %q", name)
+	}
+
+	return best, nil
+}
+
+func scoreArtistCandidate(name string, candidate SearchArtist, hints *ResolveArtistHints) float64 {
+	score := resolveWeightName * nameSimilarity(name, candidate.Name)
+	maxScore := resolveWeightName
+
+	if hints.CountryCode != nil && *hints.CountryCode != "" {
+		if strings.EqualFold(*hints.CountryCode, candidate.CountryCode) {
+			score += resolveWeightCountry
+		}
+		maxScore += resolveWeightCountry
+	}
+
+	if genre := normalizeOptionalName(hints.Genre); genre != "" {
+		for _, tag := range candidate.Tags {
+			if strings.Contains(normalizeName(tag), genre) {
+				score += resolveWeightGenre
+				break
+			}
+		}
+		maxScore += resolveWeightGenre
+	}
+
+	// followers are compared on a log scale, where 3 orders of magnitude apart scores 0
+	followers := math.Log10(float64(candidate.SpotifyFollowers) + 1)
+	if hints.SpotifyFollowers != nil {
+		expected := math.Log10(float64(*hints.SpotifyFollowers) + 1)
+		score += resolveWeightFollowers * math.Max(0, 1-math.Abs(followers-expected)/3)
+	} else {
+		score += resolveWeightFollowers * math.Min(1, followers/8)
+	}
+	maxScore += resolveWeightFollowers
+
+	return score / maxScore
+}
+
+// nameSimilarity returns a similarity between 0 and 1, based on the edit distance between normalized names.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(normalizeName(a)), []rune(normalizeName(b))
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func normalizeOptionalName(s Optional[string]) string {
+	if s == nil {
+		return ""
+	}
+
+	return normalizeName(*s)
+}
+
+func normalizeName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,76 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_Search(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /search": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "anitta", r.URL.Query().Get("q"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.SearchResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	results, err := client.Search(context.Background(), "anitta", nil)
+	require.NoError(t, err)
+	assert.Len(t, results.Artists, 3)
+	assert.Equal(t, "USWB12104213", results.Tracks[0].ISRC)
+	assert.Equal(t, "This Is Anitta", results.Playlists[chartmetric.PlaylistPlatformSpotify][0].Name)
+}
+
+func Test_Client_ResolveArtist(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /search": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "artists", r.URL.Query().Get("type"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.SearchResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+
+	match, err := client.ResolveArtist(context.Background(), "ANITTA", &chartmetric.ResolveArtistHints{
+		CountryCode: chartmetric.Opt("BR"),
+		Genre:       chartmetric.Opt("funk"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3380, match.Artist.ID)
+	assert.Greater(t, match.Confidence, 0.9)
+
+	match, err = client.ResolveArtist(context.Background(), "Anita", &chartmetric.ResolveArtistHints{
+		CountryCode:      chartmetric.Opt("US"),
+		SpotifyFollowers: chartmetric.Opt(2000),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 88101, match.Artist.ID)
+
+	// empty hints are the same as no hints
+	noHints, err := client.ResolveArtist(context.Background(), "Anitta Tribute Band", nil)
+	require.NoError(t, err)
+	emptyHints, err := client.ResolveArtist(context.Background(), "Anitta Tribute Band", &chartmetric.ResolveArtistHints{
+		CountryCode: chartmetric.Opt(""),
+		Genre:       chartmetric.Opt(""),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, noHints.Artist.ID, emptyHints.Artist.ID)
+	assert.Equal(t, noHints.Confidence, emptyHints.Confidence)
+}
@@ -39,3 +39,1378 @@ const GenresResponse = `
    ]
 }
 `
+
+const ChartCountriesResponse = `
+{
+   "obj":{
+      "countries":[
+         "US",
+         "GB",
+         "BR"
+      ]
+   }
+}
+`
+
+const ArtistResponse = `
+{
+   "obj":{
+      "id":3380,
+      "name":"Anitta",
+      "image_url":"https://i.scdn.co/image/anitta.jpg",
+      "cover_url":"https://i.scdn.co/image/anitta-cover.jpg",
+      "isni":"0000000404235547",
+      "code2":"BR",
+      "hometown_city":"Rio de Janeiro",
+      "current_city":"Miami",
+      "description":"Brazilian singer, songwriter and actress.",
+      "genres":{
+         "primary":{
+            "id":105,
+            "name":"funk carioca"
+         },
+         "secondary":[
+            {
+               "id":27,
+               "name":"pop"
+            },
+            {
+               "id":64,
+               "name":"latin"
+            }
+         ]
+      },
+      "tags":[
+         "funk",
+         "pop"
+      ],
+      "record_label":"Warner Music",
+      "career_status":{
+         "stage":"superstar",
+         "trend":"steady"
+      },
+      "cm_artist_rank":57,
+      "cm_artist_score":92.4,
+      "spotify_artist_ids":[
+         "7FNnA9vBm6EKceENgCGRMb"
+      ],
+      "itunes_artist_ids":[
+         570372593
+      ],
+      "deezer_artist_ids":[
+         "4997593"
+      ],
+      "amazon_artist_ids":[
+         "B00CXLK8DU"
+      ],
+      "youtube_channel_ids":[
+         "UCeuT-2XIGyEyl7CqmKfk3Og"
+      ],
+      "tiktok_ids":[
+         "6715213935618475013"
+      ],
+      "instagram_ids":[
+         "anitta"
+      ],
+      "soundcloud_ids":[],
+      "created_at":"2017-03-02",
+      "cm_statistics":{
+         "sp_followers":16650000,
+         "sp_monthly_listeners":31200000,
+         "sp_popularity":82,
+         "deezer_fans":2100000,
+         "ins_followers":65000000,
+         "tiktok_followers":20300000,
+         "ycs_subscribers":17400000
+      }
+   }
+}
+`
+
+const ArtistIDsResponse = `
+{
+   "obj":[
+      {
+         "cm_artist":3380,
+         "artist_name":"Anitta",
+         "spotify_artist_ids":[
+            "7FNnA9vBm6EKceENgCGRMb"
+         ],
+         "itunes_artist_ids":[
+            570372593
+         ],
+         "deezer_artist_ids":[
+            "4997593"
+         ],
+         "amazon_artist_ids":[
+            "B00CXLK8DU"
+         ],
+         "youtube_channel_ids":[
+            "UCeuT-2XIGyEyl7CqmKfk3Og"
+         ],
+         "tiktok_ids":[
+            "6715213935618475013"
+         ],
+         "instagram_ids":[
+            "anitta"
+         ],
+         "soundcloud_ids":[]
+      }
+   ]
+}
+`
+
+const ArtistStatsSpotifyResponse = `
+{
+   "obj":{
+      "followers":[
+         {
+            "value":16600000,
+            "timestp":"2024-03-01T00:00:00.000Z",
+            "diff":null
+         },
+         {
+            "value":16650000,
+            "timestp":"2024-03-02T00:00:00.000Z",
+            "diff":50000
+         }
+      ],
+      "monthly_listeners":[
+         {
+            "value":31100000,
+            "timestp":"2024-03-01T00:00:00.000Z",
+            "diff":null
+         },
+         {
+            "value":31200000,
+            "timestp":"2024-03-02T00:00:00.000Z",
+            "diff":100000,
+            "interpolated":true
+         }
+      ],
+      "popularity":[
+         {
+            "value":82,
+            "timestp":"2024-03-02T00:00:00.000Z",
+            "diff":0
+         }
+      ],
+      "listeners_to_followers_ratio":1.87
+   }
+}
+`
+
+const ArtistChartsSpotifyTopWeeklyResponse = `
+{
+   "obj":{
+      "length":2,
+      "data":[
+         {
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "cm_track":49822014,
+            "cm_artist":[
+               3380
+            ],
+            "artist_names":[
+               "Anitta"
+            ],
+            "album_ids":[
+               9128731
+            ],
+            "album_names":[
+               "Versions of Me"
+            ],
+            "album_upc":[
+               "093624871011"
+            ],
+            "album_label":[
+               "Warner Records"
+            ],
+            "release_dates":[
+               "2021-11-11"
+            ],
+            "chart_name":"Spotify Top 200 Weekly",
+            "chart_type":"regional",
+            "code2":"BR",
+            "rank":3,
+            "pre_rank":7,
+            "peak_rank":1,
+            "peak_date":"2022-03-31T00:00:00.000Z",
+            "added_at":"2022-04-07T00:00:00.000Z",
+            "time_on_chart":21,
+            "velocity":0.57,
+            "plays":2310577,
+            "rank_stats":[
+               {
+                  "rank":7,
+                  "plays":1988410,
+                  "timestp":"2022-03-31T00:00:00.000Z"
+               },
+               {
+                  "rank":3,
+                  "plays":2310577,
+                  "timestp":"2022-04-07T00:00:00.000Z"
+               }
+            ]
+         },
+         {
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "cm_track":49822014,
+            "cm_artist":[
+               3380
+            ],
+            "artist_names":[
+               "Anitta"
+            ],
+            "chart_name":"Spotify Top 200 Weekly",
+            "chart_type":"regional",
+            "code2":"GLOBAL",
+            "rank":12,
+            "pre_rank":15,
+            "peak_rank":12,
+            "peak_date":"2022-04-07T00:00:00.000Z",
+            "added_at":"2022-04-07T00:00:00.000Z",
+            "time_on_chart":4,
+            "velocity":0.2,
+            "plays":14887123
+         }
+      ]
+   }
+}
+`
+
+const ArtistPlaylistsSpotifyCurrentResponse = `
+{
+   "obj":[
+      {
+         "playlist":{
+            "id":3427,
+            "playlist_id":"37i9dQZF1DX4JAvHpjipBk",
+            "name":"New Music Friday",
+            "image_url":"https://i.scdn.co/image/nmf.jpg",
+            "owner_name":"Spotify",
+            "curator_id":2,
+            "followers":3900000,
+            "editorial":true,
+            "personalized":false,
+            "major_curator":true,
+            "chart":false,
+            "code2":"US",
+            "position":4,
+            "peak_position":2,
+            "added_at":"2024-03-01T00:00:00.000Z",
+            "removed_at":null,
+            "period":7
+         },
+         "track":{
+            "cm_track":49822014,
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "cm_artist":[
+               3380
+            ],
+            "artist_names":[
+               "Anitta"
+            ],
+            "album_ids":[
+               9128731
+            ],
+            "album_names":[
+               "Versions of Me"
+            ],
+            "release_dates":[
+               "2021-11-11"
+            ]
+         }
+      }
+   ]
+}
+`
+
+const ArtistWhereListenResponse = `
+{
+   "obj":{
+      "2024-03-02":{
+         "cities":[
+            {
+               "name":"Rio de Janeiro",
+               "city_id":3448439,
+               "code2":"BR",
+               "lat":-22.9068,
+               "lng":-43.1729,
+               "listeners":1250000
+            },
+            {
+               "name":"São Paulo",
+               "city_id":3448433,
+               "code2":"BR",
+               "lat":-23.5505,
+               "lng":-46.6333,
+               "listeners":1900000
+            }
+         ],
+         "countries":[
+            {
+               "name":"Brazil",
+               "code2":"BR",
+               "listeners":9800000
+            },
+            {
+               "name":"Mexico",
+               "code2":"MX",
+               "listeners":2900000
+            }
+         ]
+      },
+      "2024-03-01":{
+         "cities":[
+            {
+               "name":"São Paulo",
+               "city_id":3448433,
+               "code2":"BR",
+               "lat":-23.5505,
+               "lng":-46.6333,
+               "listeners":1850000
+            }
+         ],
+         "countries":[
+            {
+               "name":"Brazil",
+               "code2":"BR",
+               "listeners":9700000
+            }
+         ]
+      }
+   }
+}
+`
+
+const ArtistInstagramAudienceStatsResponse = `
+{
+   "obj":{
+      "timestp":"2024-03-02T00:00:00.000Z",
+      "followers":65000000,
+      "engagement_rate":0.0123,
+      "audience_genders":[
+         {
+            "code":"female",
+            "weight":0.61
+         },
+         {
+            "code":"male",
+            "weight":0.39
+         }
+      ],
+      "audience_genders_per_age":[
+         {
+            "code":"18-24",
+            "male":0.14,
+            "female":0.25
+         },
+         {
+            "code":"25-34",
+            "male":0.16,
+            "female":0.24
+         }
+      ],
+      "top_countries":[
+         {
+            "name":"Mexico",
+            "code2":"MX",
+            "followers":9100000,
+            "percent":0.14
+         },
+         {
+            "name":"Brazil",
+            "code2":"BR",
+            "followers":31200000,
+            "percent":0.48
+         }
+      ],
+      "top_cities":[
+         {
+            "name":"São Paulo",
+            "city_id":3448433,
+            "code2":"BR",
+            "lat":-23.5505,
+            "lng":-46.6333,
+            "followers":3900000,
+            "percent":0.06
+         }
+      ],
+      "audience_languages":[
+         {
+            "code":"pt",
+            "name":"Portuguese",
+            "weight":0.52
+         }
+      ],
+      "audience_interests":[
+         {
+            "name":"Music",
+            "weight":0.41
+         }
+      ],
+      "audience_brand_affinity":[
+         {
+            "name":"Netflix",
+            "weight":0.08
+         }
+      ],
+      "notable_followers":[
+         {
+            "username":"badbunnypr",
+            "fullname":"Bad Bunny",
+            "picture":"https://instagram.com/badbunnypr.jpg",
+            "followers":46000000,
+            "engagements":1200000,
+            "is_verified":true
+         }
+      ]
+   }
+}
+`
+
+const RelatedArtistsResponse = `
+{
+   "obj":[
+      {
+         "id":2762,
+         "name":"Ludmilla",
+         "image_url":"https://i.scdn.co/image/ludmilla.jpg",
+         "code2":"BR",
+         "genres":[
+            "funk carioca",
+            "pagode"
+         ],
+         "sp_followers":8900000,
+         "sp_monthly_listeners":7400000,
+         "sp_popularity":74,
+         "cm_artist_rank":612,
+         "cm_artist_score":81.2
+      },
+      {
+         "id":209012,
+         "name":"Pabllo Vittar",
+         "image_url":"https://i.scdn.co/image/pabllo.jpg",
+         "code2":"BR",
+         "genres":[
+            "pop"
+         ],
+         "sp_followers":5100000,
+         "sp_monthly_listeners":4800000,
+         "sp_popularity":70,
+         "cm_artist_rank":1220,
+         "cm_artist_score":77.9
+      }
+   ]
+}
+`
+
+const ArtistAlbumsResponse = `
+{
+   "obj":[
+      {
+         "cm_album":9128731,
+         "name":"Versions of Me",
+         "image_url":"https://i.scdn.co/image/versions-of-me.jpg",
+         "upc":"093624871011",
+         "label":"Warner Records",
+         "release_date":"2022-04-12",
+         "num_tracks":15,
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      }
+   ]
+}
+`
+
+const ArtistTracksPage1Response = `
+{
+   "obj":[
+      {
+         "cm_track":49822014,
+         "name":"Envolver",
+         "isrc":"USWB12104213",
+         "label":"Warner Records",
+         "release_date":"2021-11-11",
+         "album_ids":[
+            9128731
+         ],
+         "album_names":[
+            "Versions of Me"
+         ],
+         "album_upc":[
+            "093624871011"
+         ],
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      },
+      {
+         "cm_track":51001233,
+         "name":"Boys Don't Cry",
+         "isrc":"USWB12200193",
+         "label":"Warner Records",
+         "release_date":"2022-01-27",
+         "album_ids":[
+            9128731
+         ],
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      }
+   ]
+}
+`
+
+const ArtistTracksPage2Response = `
+{
+   "obj":[
+      {
+         "cm_track":62290211,
+         "name":"Funk Rave",
+         "isrc":"USWB12302010",
+         "label":"Warner Records",
+         "release_date":"2023-06-22",
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      }
+   ]
+}
+`
+
+const TrackIDsResponse = `
+{
+   "obj":[
+      {
+         "isrc":"USWB12104213",
+         "chartmetric_ids":[
+            49822014
+         ],
+         "spotify_ids":[
+            "3ebXMykcMXOcLeJ9xZ17XH"
+         ],
+         "itunes_ids":[
+            "1592396282"
+         ],
+         "deezer_ids":[
+            "1549325802"
+         ],
+         "amazon_ids":[
+            "B09LHXJ7JH"
+         ],
+         "youtube_ids":[
+            "fHEDy_Ryxx4"
+         ],
+         "soundcloud_ids":[],
+         "shazam_ids":[
+            "591539321"
+         ],
+         "tiktok_ids":[
+            "7030066223532019713"
+         ],
+         "beatport_ids":[],
+         "qq_ids":[],
+         "genius_ids":[
+            7460352
+         ]
+      }
+   ]
+}
+`
+
+const SearchResponse = `
+{
+   "obj":{
+      "artists":[
+         {
+            "id":88101,
+            "name":"Anita",
+            "image_url":"https://i.scdn.co/image/anita.jpg",
+            "code2":"US",
+            "tags":[
+               "indie"
+            ],
+            "verified":false,
+            "sp_followers":2100,
+            "sp_monthly_listeners":800,
+            "cm_artist_score":12.1
+         },
+         {
+            "id":3380,
+            "name":"Anitta",
+            "image_url":"https://i.scdn.co/image/anitta.jpg",
+            "code2":"BR",
+            "tags":[
+               "funk carioca",
+               "pop"
+            ],
+            "verified":true,
+            "sp_followers":16650000,
+            "sp_monthly_listeners":31200000,
+            "cm_artist_score":92.4
+         },
+         {
+            "id":4410921,
+            "name":"Anitta Tribute Band",
+            "code2":"BR",
+            "tags":[],
+            "sp_followers":150,
+            "sp_monthly_listeners":20
+         }
+      ],
+      "tracks":[
+         {
+            "id":49822014,
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "cm_artist":[
+               3380
+            ],
+            "artist_names":[
+               "Anitta"
+            ]
+         }
+      ],
+      "albums":[],
+      "playlists":{
+         "spotify":[
+            {
+               "id":51231,
+               "name":"This Is Anitta",
+               "owner_name":"Spotify",
+               "followers":620000
+            }
+         ]
+      },
+      "curators":{},
+      "stations":[],
+      "cities":[],
+      "labels":[],
+      "songwriters":[]
+   }
+}
+`
+
+const ArtistInsightsResponse = `
+{
+   "obj":[
+      {
+         "id":90311,
+         "type":"chart",
+         "platform":"spotify",
+         "weight":8,
+         "text":"Envolver debuted at #1 on the Spotify Top 50 Global chart",
+         "timestp":"2022-03-15T00:00:00.000Z",
+         "data":{
+            "rank":1
+         }
+      },
+      {
+         "id":88720,
+         "type":"milestone",
+         "platform":"instagram",
+         "weight":5,
+         "text":"Reached 60M Instagram followers",
+         "timestp":"2021-12-01T00:00:00.000Z",
+         "data":{
+            "value":60000000
+         }
+      }
+   ]
+}
+`
+
+const ArtistCareerResponse = `
+{
+   "obj":[
+      {
+         "timestp":"2022-03-01T00:00:00.000Z",
+         "stage":"mainstream",
+         "trend":"explosive growth",
+         "stage_score":81.5,
+         "momentum_score":97.2
+      },
+      {
+         "timestp":"2022-01-01T00:00:00.000Z",
+         "stage":"mid-level",
+         "trend":"growth",
+         "stage_score":74.1,
+         "momentum_score":80.3
+      },
+      {
+         "timestp":"2022-02-01T00:00:00.000Z",
+         "stage":"mid-level",
+         "trend":"growth",
+         "stage_score":77.9,
+         "momentum_score":88
+      }
+   ]
+}
+`
+
+const FilterArtistsResponse = `
+{
+   "obj":[
+      {
+         "id":2762,
+         "name":"Ludmilla",
+         "code2":"BR",
+         "genres":[
+            "funk carioca"
+         ],
+         "career_stage":"mainstream",
+         "cm_artist_rank":612,
+         "cm_artist_score":81.2,
+         "sp_followers":8900000,
+         "sp_monthly_listeners":470000,
+         "sp_popularity":74
+      },
+      {
+         "id":501133,
+         "name":"MC Ryan SP",
+         "code2":"BR",
+         "genres":[
+            "funk"
+         ],
+         "career_stage":"mid-level",
+         "cm_artist_rank":2401,
+         "cm_artist_score":70.4,
+         "sp_followers":1200000,
+         "sp_monthly_listeners":310000,
+         "sp_popularity":69
+      }
+   ]
+}
+`
+
+const ArtistPastEventsResponse = `
+{
+   "obj":[
+      {
+         "id":771201,
+         "name":"Anitta - Ensaios da Anitta",
+         "date":"2024-03-05",
+         "venue_name":"Marina da Glória",
+         "venue_capacity":15000,
+         "city":"Rio de Janeiro",
+         "code2":"BR",
+         "lat":-22.9205,
+         "lng":-43.1706,
+         "ticket_source":"sympla",
+         "ticket_url":"https://www.sympla.com.br/ensaios-da-anitta"
+      }
+   ]
+}
+`
+
+const ArtistCMScoreResponse = `
+{
+   "obj":[
+      {
+         "timestp":"2024-03-01T00:00:00.000Z",
+         "score":92.1
+      },
+      {
+         "timestp":"2024-03-02T00:00:00.000Z",
+         "score":92.4
+      }
+   ]
+}
+`
+
+const ArtistCMRankGenreResponse = `
+{
+   "obj":[
+      {
+         "timestp":"2024-03-01T00:00:00.000Z",
+         "rank":3,
+         "genre":"latin"
+      },
+      {
+         "timestp":"2024-03-02T00:00:00.000Z",
+         "rank":2,
+         "genre":"latin"
+      }
+   ]
+}
+`
+
+const ArtistURLsResponse = `
+{
+   "obj":[
+      {
+         "domain":"spotify",
+         "url":[
+            "https://open.spotify.com/artist/7FNnA9vBm6EKceENgCGRMb"
+         ]
+      },
+      {
+         "domain":"instagram",
+         "url":[
+            "https://www.instagram.com/anitta"
+         ]
+      },
+      {
+         "domain":"youtube",
+         "url":[
+            "https://www.youtube.com/channel/UCeuT-2XIGyEyl7CqmKfk3Og"
+         ]
+      }
+   ]
+}
+`
+
+const AlbumResponse = `
+{
+   "obj":{
+      "id":9128731,
+      "name":"Versions of Me",
+      "image_url":"https://i.scdn.co/image/versions-of-me.jpg",
+      "upc":"093624871011",
+      "label":"Warner Records",
+      "release_date":"2022-04-12",
+      "num_tracks":15,
+      "explicit":true,
+      "description":"Third studio album by Anitta.",
+      "genres":[
+         {
+            "id":92,
+            "name":"funk carioca"
+         }
+      ],
+      "artists":[
+         {
+            "id":3380,
+            "name":"Anitta"
+         }
+      ],
+      "cm_album_score":71.3,
+      "spotify_album_ids":[
+         "7rnGHvfNyTMqUJt6k8ZBCb"
+      ],
+      "itunes_album_ids":[
+         "1612491787"
+      ],
+      "deezer_album_ids":[
+         "305464887"
+      ],
+      "amazon_album_ids":[
+         "B09WJJ8M8D"
+      ],
+      "spotify_popularity":64
+   }
+}
+`
+
+const AlbumTracksResponse = `
+{
+   "obj":[
+      {
+         "cm_track":49822014,
+         "name":"Envolver",
+         "isrc":"USWB12104213",
+         "label":"Warner Records",
+         "release_date":"2021-11-11",
+         "album_ids":[
+            9128731
+         ],
+         "album_names":[
+            "Versions of Me"
+         ],
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      },
+      {
+         "cm_track":50193410,
+         "name":"Boys Don't Cry",
+         "isrc":"USWB12200123",
+         "label":"Warner Records",
+         "release_date":"2022-01-27",
+         "album_ids":[
+            9128731
+         ],
+         "album_names":[
+            "Versions of Me"
+         ],
+         "cm_artist":[
+            3380
+         ],
+         "artist_names":[
+            "Anitta"
+         ]
+      }
+   ]
+}
+`
+
+const AlbumIDsResponse = `
+{
+   "obj":[
+      {
+         "upc":"093624871011",
+         "chartmetric_ids":[
+            9128731
+         ],
+         "spotify_ids":[
+            "7rnGHvfNyTMqUJt6k8ZBCb"
+         ],
+         "itunes_ids":[
+            "1612491787"
+         ],
+         "deezer_ids":[
+            "305464887"
+         ],
+         "amazon_ids":[
+            "B09WJJ8M8D"
+         ]
+      }
+   ]
+}
+`
+
+const AlbumStatsSpotifyResponse = `
+{
+   "obj":{
+      "popularity":[
+         {
+            "value":61,
+            "timestp":"2022-04-12T00:00:00.000Z",
+            "diff":null
+         },
+         {
+            "value":64,
+            "timestp":"2022-04-13T00:00:00.000Z",
+            "diff":3
+         }
+      ],
+      "streams":[
+         {
+            "value":5120334,
+            "timestp":"2022-04-13T00:00:00.000Z",
+            "diff":null
+         }
+      ],
+      "num_tracks":15
+   }
+}
+`
+
+const AlbumChartsAppleMusicResponse = `
+{
+   "obj":{
+      "length":1,
+      "data":[
+         {
+            "name":"Versions of Me",
+            "cm_artist":[
+               3380
+            ],
+            "album_ids":[
+               9128731
+            ],
+            "album_upc":[
+               "093624871011"
+            ],
+            "album_label":[
+               "Warner Records"
+            ],
+            "chart_name":"Apple Music Top Albums",
+            "chart_type":"regional",
+            "code2":"BR",
+            "rank":2,
+            "pre_rank":5,
+            "peak_rank":1,
+            "peak_date":"2022-04-13T00:00:00.000Z",
+            "added_at":"2022-04-13T00:00:00.000Z",
+            "time_on_chart":4,
+            "velocity":0.6
+         }
+      ]
+   }
+}
+`
+
+const AlbumPlaylistsSpotifyPastPage1Response = `
+{
+   "obj":[
+      {
+         "playlist":{
+            "id":3427,
+            "playlist_id":"37i9dQZF1DX4JAvHpjipBk",
+            "name":"New Music Friday",
+            "followers":3900000,
+            "editorial":true,
+            "position":4,
+            "peak_position":2,
+            "added_at":"2022-04-15T00:00:00.000Z",
+            "removed_at":"2022-04-22T00:00:00.000Z",
+            "period":7
+         },
+         "track":{
+            "cm_track":50193410,
+            "name":"Boys Don't Cry",
+            "isrc":"USWB12200123",
+            "album_ids":[
+               9128731
+            ]
+         }
+      },
+      {
+         "playlist":{
+            "id":5521,
+            "playlist_id":"37i9dQZF1DWWGFQLoP9qlv",
+            "name":"Funk Hits",
+            "followers":1200000,
+            "editorial":true,
+            "position":1,
+            "peak_position":1,
+            "added_at":"2022-04-12T00:00:00.000Z",
+            "removed_at":"2022-05-10T00:00:00.000Z",
+            "period":28
+         },
+         "track":{
+            "cm_track":49822014,
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "album_ids":[
+               9128731
+            ]
+         }
+      }
+   ]
+}
+`
+
+const AlbumPlaylistsSpotifyPastPage2Response = `
+{
+   "obj":[
+      {
+         "playlist":{
+            "id":7810,
+            "playlist_id":"37i9dQZF1DX0FOF1IUWK1W",
+            "name":"Latin Pop Rising",
+            "followers":870000,
+            "editorial":true,
+            "position":12,
+            "peak_position":9,
+            "added_at":"2022-04-15T00:00:00.000Z",
+            "removed_at":"2022-04-29T00:00:00.000Z",
+            "period":14
+         },
+         "track":{
+            "cm_track":50193410,
+            "name":"Boys Don't Cry",
+            "isrc":"USWB12200123",
+            "album_ids":[
+               9128731
+            ]
+         }
+      }
+   ]
+}
+`
+
+const TrackResponse = `
+{
+   "obj":{
+      "id":49822014,
+      "name":"Envolver",
+      "isrc":"USWB12104213",
+      "image_url":"https://i.scdn.co/image/envolver.jpg",
+      "duration_ms":193266,
+      "explicit":false,
+      "release_date":"2021-11-11",
+      "label":"Warner Records",
+      "albums":[
+         {
+            "id":9128731,
+            "name":"Versions of Me",
+            "upc":"093624871011",
+            "label":"Warner Records",
+            "release_date":"2022-04-12"
+         }
+      ],
+      "artists":[
+         {
+            "id":3380,
+            "name":"Anitta"
+         }
+      ],
+      "genres":[
+         {
+            "id":92,
+            "name":"funk carioca"
+         },
+         {
+            "id":118,
+            "name":"reggaeton"
+         }
+      ],
+      "audio_features":{
+         "tempo":91.97,
+         "key":10,
+         "mode":0,
+         "time_signature":4,
+         "loudness":-5.12,
+         "acousticness":0.0783,
+         "danceability":0.812,
+         "energy":0.733,
+         "instrumentalness":0,
+         "liveness":0.0902,
+         "speechiness":0.0787,
+         "valence":0.396
+      },
+      "cm_track_score":88.6,
+      "songwriters":[
+         {
+            "name":"Larissa de Macedo Machado",
+            "cm_artist":3380
+         },
+         {
+            "name":"Ryan Tedder",
+            "cm_artist":null
+         }
+      ],
+      "producers":[
+         {
+            "name":"Ryan Tedder",
+            "cm_artist":null
+         }
+      ]
+   }
+}
+`
+
+const TrackStatsSpotifyStreamsResponse = `
+{
+   "obj":[
+      {
+         "domain":"spotify",
+         "id":"3ebXMykcMXOcLeJ9xZ17XH",
+         "data":[
+            {
+               "value":412000000,
+               "timestp":"2024-03-01T00:00:00.000Z",
+               "diff":null
+            },
+            {
+               "value":412350000,
+               "timestp":"2024-03-02T00:00:00.000Z",
+               "diff":350000
+            },
+            {
+               "value":412690000,
+               "timestp":"2024-03-03T00:00:00.000Z",
+               "diff":340000,
+               "interpolated":true
+            }
+         ]
+      },
+      {
+         "domain":"spotify",
+         "id":"1QtWnPSahAy3H2A1qtiGE3",
+         "data":[
+            {
+               "value":25100000,
+               "timestp":"2024-03-01T00:00:00.000Z",
+               "diff":null
+            },
+            {
+               "value":25140000,
+               "timestp":"2024-03-02T00:00:00.000Z",
+               "diff":40000
+            }
+         ]
+      }
+   ]
+}
+`
+
+const TrackChartsTikTokResponse = `
+{
+   "obj":{
+      "length":1,
+      "data":[
+         {
+            "name":"Envolver",
+            "isrc":"USWB12104213",
+            "cm_track":49822014,
+            "cm_artist":[
+               3380
+            ],
+            "artist_names":[
+               "Anitta"
+            ],
+            "chart_name":"TikTok Top Tracks",
+            "chart_type":"regional",
+            "code2":"US",
+            "rank":4,
+            "pre_rank":11,
+            "peak_rank":4,
+            "peak_date":"2022-01-13T00:00:00.000Z",
+            "added_at":"2022-01-06T00:00:00.000Z",
+            "time_on_chart":2,
+            "velocity":3.5,
+            "posts":184000,
+            "rank_stats":[
+               {
+                  "rank":11,
+                  "posts":97000,
+                  "timestp":"2022-01-06T00:00:00.000Z"
+               },
+               {
+                  "rank":4,
+                  "posts":184000,
+                  "timestp":"2022-01-13T00:00:00.000Z"
+               }
+            ]
+         }
+      ]
+   }
+}
+`
+
+const TrackPlaylistsSpotifyCurrentResponse = `
+{
+   "obj":[
+      {
+         "playlist":{
+            "id":3427,
+            "playlist_id":"37i9dQZF1DX4JAvHpjipBk",
+            "name":"New Music Friday",
+            "owner_name":"Spotify",
+            "followers":3900000,
+            "editorial":true,
+            "personalized":false,
+            "major_curator":true,
+            "code2":"US",
+            "position":4,
+            "peak_position":2,
+            "added_at":"2024-03-01T00:00:00.000Z",
+            "removed_at":null,
+            "period":7
+         },
+         "track":{
+            "cm_track":49822014,
+            "name":"Envolver",
+            "isrc":"USWB12104213"
+         }
+      },
+      {
+         "playlist":{
+            "id":5521,
+            "playlist_id":"37i9dQZF1DWWGFQLoP9qlv",
+            "name":"Funk Hits",
+            "owner_name":"Spotify",
+            "followers":1200000,
+            "editorial":true,
+            "personalized":false,
+            "major_curator":true,
+            "code2":"BR",
+            "position":1,
+            "peak_position":1,
+            "added_at":"2024-02-23T00:00:00.000Z",
+            "removed_at":null,
+            "period":14
+         },
+         "track":{
+            "cm_track":49822014,
+            "name":"Envolver",
+            "isrc":"USWB12104213"
+         }
+      }
+   ]
+}
+`
+
+const ArtistStatsMalformedResponse = `
+{
+   "obj":{
+      "followers":[
+         {
+            "value":16600000,
+            "timestp":"2024-03-01T00:00:00.000Z",
+            "diff":null
+         }
+      ],
+      "popularity":[
+         {
+            "value":82,
+            "timestp":"yesterday",
+            "diff":0
+         }
+      ],
+      "listeners_to_followers_ratio":1.87
+   }
+}
+`
+
+const AlbumStatsMalformedResponse = `
+{
+   "obj":{
+      "popularity":[
+         {
+            "value":"sixty-four",
+            "timestp":"2022-04-13T00:00:00.000Z",
+            "diff":3
+         }
+      ],
+      "num_tracks":15
+   }
+}
+`
+
+const ArtistChartsTimestampEdgeCasesResponse = `
+{
+   "obj":{
+      "length":3,
+      "data":[
+         {
+            "name":"Envolver",
+            "cm_track":49822014,
+            "chart_name":"Spotify Top 200 Weekly",
+            "code2":"BR",
+            "rank":3,
+            "added_at":"2022-04-07T15:30:00.000Z"
+         },
+         {
+            "name":"Girl From Rio",
+            "cm_track":41873309,
+            "chart_name":"Spotify Top 200 Weekly",
+            "code2":"BR",
+            "rank":48,
+            "added_at":"2021-06-03T00:00:00.000Z"
+         },
+         {
+            "name":"Faking Love",
+            "cm_track":42207155,
+            "chart_name":"Spotify Top 200 Weekly",
+            "code2":"BR",
+            "rank":61
+         }
+      ]
+   }
+}
+`
@@ -6,8 +6,93 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"time"
 )
 
+type getTrackResponse struct {
+	Obj Track `json:"obj"`
+}
+
+type Track struct {
+	ID                    int            `json:"id"`
+	Name                  string         `json:"name"`
+	ISRC                  string         `json:"isrc"`
+	ImageURL              string         `json:"image_url"`
+	DurationMS            int            `json:"duration_ms"`
+	Explicit              bool           `json:"explicit"`
+	ReleaseDate           Date           `json:"release_date"`
+	Label                 string         `json:"label"`
+	Albums                []TrackAlbum   `json:"albums"`
+	Artists               []TrackArtist  `json:"artists"`
+	Genres                []Genre        `json:"genres"`
+	AudioFeatures         *AudioFeatures `json:"audio_features"`
+	ChartmetricTrackScore float64        `json:"cm_track_score"`
+	Songwriters           []TrackCredit  `json:"songwriters"`
+	Producers             []TrackCredit  `json:"producers"`
+}
+
+// Duration returns the duration of the track.
+func (t Track) Duration() time.Duration {
+	return time.Duration(t.DurationMS) * time.Millisecond
+}
+
+type TrackAlbum struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	UPC         string `json:"upc"`
+	Label       string `json:"label"`
+	ReleaseDate Date   `json:"release_date"`
+}
+
+type TrackArtist struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TrackCredit is a songwriter or producer credited on a track.
+// ChartmetricArtistID is zero when the credited person has no Chartmetric artist profile.
+type TrackCredit struct {
+	Name                string `json:"name"`
+	ChartmetricArtistID int    `json:"cm_artist"`
+}
+
+// AudioFeatures are the audio analysis features of a track, when available.
+type AudioFeatures struct {
+	Tempo            float64 `json:"tempo"`
+	Key              int     `json:"key"`
+	Mode             int     `json:"mode"`
+	TimeSignature    int     `json:"time_signature"`
+	Loudness         float64 `json:"loudness"`
+	Acousticness     float64 `json:"acousticness"`
+	Danceability     float64 `json:"danceability"`
+	Energy           float64 `json:"energy"`
+	Instrumentalness float64 `json:"instrumentalness"`
+	Liveness         float64 `json:"liveness"`
+	Speechiness      float64 `json:"speechiness"`
+	Valence          float64 `json:"valence"`
+}
+
+// GetTrack fetches the metadata of a track by its Chartmetric ID, including its credits.
+// See https://api.chartmetric.com/apidoc/#api-Track-GetTrackMetadata.
+func (c *Client) GetTrack(ctx context.Context, id int) (*Track, error) {
+	path := fmt.Sprintf("/track/%d", id)
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getTrackResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return &response.Obj, nil
+}
+
+// ==================================================
+
 type TrackPlatform string
 
 const (
@@ -68,3 +153,190 @@ func (c *Client) GetTrackIDs(ctx context.Context, platform TrackPlatform, id str
 
 	return &response.Obj[0], nil
 }
+
+// ==================================================
+
+type TrackStatPlatform string
+
+const (
+	TrackStatPlatformDeezer     TrackStatPlatform = "deezer"
+	TrackStatPlatformGenius     TrackStatPlatform = "genius"
+	TrackStatPlatformShazam     TrackStatPlatform = "shazam"
+	TrackStatPlatformSoundCloud TrackStatPlatform = "soundcloud"
+	TrackStatPlatformSpotify    TrackStatPlatform = "spotify"
+	TrackStatPlatformTikTok     TrackStatPlatform = "tiktok"
+	TrackStatPlatformYouTube    TrackStatPlatform = "youtube"
+)
+
+// TrackStatType selects the metric of platforms that track more than one,
+// e.g. streams or popularity on Spotify.
+type TrackStatType string
+
+const (
+	TrackStatTypeCounts     TrackStatType = "counts"
+	TrackStatTypeFans       TrackStatType = "fans"
+	TrackStatTypeLikes      TrackStatType = "likes"
+	TrackStatTypePageViews  TrackStatType = "pageviews"
+	TrackStatTypePlays      TrackStatType = "plays"
+	TrackStatTypePopularity TrackStatType = "popularity"
+	TrackStatTypePosts      TrackStatType = "posts"
+	TrackStatTypeStreams    TrackStatType = "streams"
+	TrackStatTypeViews      TrackStatType = "views"
+)
+
+type TrackStatMode string
+
+const (
+	TrackStatModeCumulative TrackStatMode = "cumulative"
+	TrackStatModeDaily      TrackStatMode = "daily"
+)
+
+type GetTrackStatsParams struct {
+	Since  Optional[time.Time]
+	Until  Optional[time.Time]
+	Type   Optional[TrackStatType]
+	Mode   Optional[TrackStatMode]
+	Latest Optional[bool]
+}
+
+type getTrackStatsResponse struct {
+	Obj []TrackStatSeries `json:"obj"`
+}
+
+// TrackStatSeries is the time series of a track's metric for one of the track's IDs on a platform.
+type TrackStatSeries struct {
+	Domain TrackStatPlatform `json:"domain"`
+	// ID is the track's ID on the platform, e.g. one of its Spotify IDs.
+	ID   string      `json:"id"`
+	Data []StatPoint `json:"data"`
+}
+
+// GetTrackStats fetches the time series of a track's metric on a particular platform.
+// A track can have several IDs on the same platform (e.g. a single and an album version on Spotify),
+// in which case there is one series per ID, each with the counts of that ID only (see SumTrackStatSeries
+// for the track's total).
+// See https://api.chartmetric.com/apidoc/#api-Track-GetTrackStats.
+func (c *Client) GetTrackStats(ctx context.Context, id int, platform TrackStatPlatform, params *GetTrackStatsParams) ([]TrackStatSeries, error) {
+	path := fmt.Sprintf("/track/%d/%s/stats", id, platform)
+
+	var queryParams map[string]any
+	if params != nil {
+		queryParams = make(map[string]any)
+		if params.Since != nil {
+			queryParams["since"] = (*params.Since).Format(DateFormat)
+		}
+		if params.Until != nil {
+			queryParams["until"] = (*params.Until).Format(DateFormat)
+		}
+		if params.Type != nil {
+			queryParams["type"] = *params.Type
+		}
+		if params.Mode != nil {
+			queryParams["mode"] = *params.Mode
+		}
+		if params.Latest != nil {
+			queryParams["latest"] = *params.Latest
+		}
+	}
+
+	responseData, err := c.requestWithRetry(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request with retry: %w", err)
+	}
+
+	var response getTrackStatsResponse
+	if err := json.Unmarshal(responseData, &response); err != nil {
+		return nil, fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	return response.Obj, nil
+}
+
+// SumTrackStatSeries sums the series of a track's IDs (see GetTrackStats) date by date, into the series
+// of the whole track, sorted by date. It only makes sense for additive metrics, such as streams or views,
+// not for popularity. A date missing from a series adds nothing for that series, and a summed point
+// is interpolated if any of its points is.
+func SumTrackStatSeries(series []TrackStatSeries) []StatPoint {
+	var sums []StatPoint
+	indexes := make(map[int64]int)
+	for _, s := range series {
+		for _, point := range s.Data {
+			i, ok := indexes[point.Timestamp.Unix()]
+			if !ok {
+				i = len(sums)
+				indexes[point.Timestamp.Unix()] = i
+				sums = append(sums, StatPoint{Timestamp: point.Timestamp})
+			}
+			sums[i].Value += point.Value
+			sums[i].Diff += point.Diff
+			sums[i].Interpolated = sums[i].Interpolated || point.Interpolated
+		}
+	}
+
+	slices.SortFunc(sums, func(a, b StatPoint) int {
+		return a.Timestamp.Compare(b.Timestamp.Time)
+	})
+
+	return sums
+}
+
+// ==================================================
+
+type TrackChartType string
+
+const (
+	TrackChartTypeAirplayDaily        TrackChartType = "airplay_daily"
+	TrackChartTypeAirplayWeekly       TrackChartType = "airplay_weekly"
+	TrackChartTypeAmazon              TrackChartType = "amazon"
+	TrackChartTypeAppleMusicDaily     TrackChartType = "applemusic_daily"
+	TrackChartTypeAppleMusicTop       TrackChartType = "applemusic_top"
+	TrackChartTypeAppleMusicVideos    TrackChartType = "applemusic_videos"
+	TrackChartTypeBeatport            TrackChartType = "beatport"
+	TrackChartTypeDeezer              TrackChartType = "deezer"
+	TrackChartTypeITunesTop           TrackChartType = "itunes_top"
+	TrackChartTypeITunesVideos        TrackChartType = "itunes_videos"
+	TrackChartTypeShazamTopDaily      TrackChartType = "shazam_top_daily"
+	TrackChartTypeShazamTrendingDaily TrackChartType = "shazam_trending_daily"
+	TrackChartTypeSoundCloud          TrackChartType = "soundcloud"
+	TrackChartTypeSpotifyTopDaily     TrackChartType = "spotify_top_daily"
+	TrackChartTypeSpotifyTopWeekly    TrackChartType = "spotify_top_weekly"
+	TrackChartTypeSpotifyViralDaily   TrackChartType = "spotify_viral_daily"
+	TrackChartTypeSpotifyViralWeekly  TrackChartType = "spotify_viral_weekly"
+	TrackChartTypeTikTokTopTracks     TrackChartType = "tiktok_top_tracks"
+	TrackChartTypeYouTubeTracks       TrackChartType = "youtube_tracks"
+	TrackChartTypeYouTubeTrends       TrackChartType = "youtube_trends"
+	TrackChartTypeYouTubeVideos       TrackChartType = "youtube_videos"
+)
+
+type GetTrackChartsParams struct {
+	Since time.Time
+	Until Optional[time.Time]
+}
+
+// GetTrackCharts fetches the appearances of a track on a particular chart, with its rank history.
+// See https://api.chartmetric.com/apidoc/#api-Track-GetTrackCharts.
+func (c *Client) GetTrackCharts(ctx context.Context, id int, chartType TrackChartType, params GetTrackChartsParams) ([]ChartHistoryEntry, error) {
+	path := fmt.Sprintf("/track/%d/%s/charts", id, chartType)
+
+	entries, err := c.getChartHistory(ctx, path, params.Since, params.Until)
+	if err != nil {
+		return nil, fmt.Errorf("get chart history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ==================================================
+
+// GetTrackPlaylists fetches the current or past playlist placements of a track on a particular platform.
+// See https://api.chartmetric.com/apidoc/#api-Track-GetTrackPlaylists.
+func (c *Client) GetTrackPlaylists(ctx context.Context, id int, platform PlaylistPlatform, status PlaylistStatus, params *GetPlaylistPlacementsParams) ([]PlaylistPlacement, error) {
+	path := fmt.Sprintf("/track/%d/%s/%s/playlists", id, platform, status)
+
+	placements, err := c.getPlaylistPlacements(ctx, path, params)
+	if err != nil {
+		return nil, fmt.Errorf("get playlist placements: %w", err)
+	}
+
+	return placements, nil
+}
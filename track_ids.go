@@ -0,0 +1,338 @@
+package chartmetric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+const defaultResolveConcurrency = 4
+
+type ResolveTrackIDsOptions struct {
+	// Concurrency is the number of concurrent requests. Defaults to 4.
+	// Requests still go through the Client's rate limiter, whatever the concurrency.
+	Concurrency int
+	// Graph, when set, answers the IDs it already knows without any request,
+	// and is updated with every answer fetched from the API.
+	Graph *IDGraph
+}
+
+// TrackIDsResult is the outcome of resolving a single ID with ResolveTrackIDs.
+type TrackIDsResult struct {
+	ID  string
+	IDs *TrackIDs
+	Err error
+}
+
+// ResolveTrackIDs resolves many track IDs of a platform to their cross-platform IDs (see GetTrackIDs),
+// fetching them over a bounded pool of workers. The results are in the order of ids, and an ID that
+// fails to resolve carries its error without stopping the others.
+func (c *Client) ResolveTrackIDs(ctx context.Context, platform TrackPlatform, ids []string, opts *ResolveTrackIDsOptions) []TrackIDsResult {
+	if opts == nil {
+		opts = &ResolveTrackIDsOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultResolveConcurrency
+	}
+
+	results := make([]TrackIDsResult, len(ids))
+
+	// duplicate IDs are resolved once, then copied over
+	firstIndex := make(map[string]int, len(ids))
+	var jobs []int
+	for i, id := range ids {
+		results[i].ID = id
+		if _, ok := firstIndex[id]; !ok {
+			firstIndex[id] = i
+			jobs = append(jobs, i)
+		}
+	}
+
+	jobCh := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(jobs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobCh {
+				results[i].IDs, results[i].Err = c.resolveTrackIDs(ctx, platform, ids[i], opts.Graph)
+			}
+		}()
+	}
+	for _, i := range jobs {
+		jobCh <- i
+	}
+	close(jobCh)
+	wg.Wait()
+
+	for i, id := range ids {
+		if first := firstIndex[id]; first != i {
+			results[i].IDs, results[i].Err = results[first].IDs, results[first].Err
+		}
+	}
+
+	return results
+}
+
+func (c *Client) resolveTrackIDs(ctx context.Context, platform TrackPlatform, id string, graph *IDGraph) (*TrackIDs, error) {
+	if graph != nil {
+		if trackIDs, ok := graph.Lookup(platform, id); ok {
+			return trackIDs, nil
+		}
+	}
+
+	trackIDs, err := c.GetTrackIDs(ctx, platform, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if graph != nil {
+		graph.Add(*trackIDs)
+	}
+
+	return trackIDs, nil
+}
+
+// ==================================================
+
+type idGraphKey struct {
+	platform TrackPlatform
+	id       string
+}
+
+// IDGraph is a cache of cross-platform track IDs. Every TrackIDs added to it is merged with the tracks
+// it shares an ID with, so that a track can then be looked up by any of its IDs, on any platform.
+// When tracks with different ISRCs get merged, all the ISRCs are kept: each of them looks the track up,
+// and they are all exported.
+// It is safe for concurrent use, and can be exported and imported with encoding/json.
+type IDGraph struct {
+	mu      sync.RWMutex
+	tracks  map[int]*idGraphTrack
+	index   map[idGraphKey]int
+	nextRef int
+}
+
+// idGraphTrack is a track of an IDGraph. TrackIDs.ISRC is the first of its ISRCs.
+type idGraphTrack struct {
+	TrackIDs
+	ISRCs []string `json:"isrcs,omitempty"`
+}
+
+func newIDGraphTrack(trackIDs TrackIDs) idGraphTrack {
+	track := idGraphTrack{TrackIDs: trackIDs}
+	if trackIDs.ISRC != "" {
+		track.ISRCs = []string{trackIDs.ISRC}
+	}
+
+	return track
+}
+
+func (t *idGraphTrack) merge(other idGraphTrack) {
+	mergeTrackIDs(&t.TrackIDs, other.TrackIDs)
+	t.ISRCs = appendMissing(t.ISRCs, other.ISRCs)
+}
+
+// keys returns the keys of all the IDs of the track, including every one of its ISRCs.
+func (t *idGraphTrack) keys() []idGraphKey {
+	keys := trackIDKeys(t.TrackIDs)
+	for _, isrc := range t.ISRCs {
+		if isrc != t.ISRC {
+			keys = append(keys, idGraphKey{TrackPlatformISRC, isrc})
+		}
+	}
+
+	return keys
+}
+
+// NewIDGraph returns an empty IDGraph.
+func NewIDGraph() *IDGraph {
+	return &IDGraph{
+		tracks: make(map[int]*idGraphTrack),
+		index:  make(map[idGraphKey]int),
+	}
+}
+
+// Add merges trackIDs into the graph, joining all the tracks that share an ID with it.
+func (g *IDGraph) Add(trackIDs TrackIDs) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.add(newIDGraphTrack(trackIDs))
+}
+
+func (g *IDGraph) add(track idGraphTrack) {
+	if g.tracks == nil {
+		g.tracks = make(map[int]*idGraphTrack)
+		g.index = make(map[idGraphKey]int)
+	}
+
+	var refs []int
+	for _, key := range track.keys() {
+		if ref, ok := g.index[key]; ok && !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	var ref int
+	if len(refs) == 0 {
+		ref = g.nextRef
+		g.nextRef++
+		g.tracks[ref] = &idGraphTrack{}
+	} else {
+		ref = refs[0]
+		for _, other := range refs[1:] {
+			g.tracks[ref].merge(*g.tracks[other])
+			delete(g.tracks, other)
+		}
+	}
+
+	// every key of the merged tracks, deleted ones included, now points at ref
+	merged := g.tracks[ref]
+	merged.merge(track)
+	for _, key := range merged.keys() {
+		g.index[key] = ref
+	}
+}
+
+// Lookup returns the cross-platform IDs of the track that has the given ID on the given platform, if known.
+func (g *IDGraph) Lookup(platform TrackPlatform, id string) (*TrackIDs, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	ref, ok := g.index[idGraphKey{platform, id}]
+	if !ok {
+		return nil, false
+	}
+
+	trackIDs := cloneTrackIDs(g.tracks[ref].TrackIDs)
+	return &trackIDs, true
+}
+
+// Len returns the number of distinct tracks in the graph.
+func (g *IDGraph) Len() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return len(g.tracks)
+}
+
+type idGraphJSON struct {
+	Tracks []idGraphTrack `json:"tracks"`
+}
+
+// MarshalJSON exports the graph as the list of its tracks.
+func (g *IDGraph) MarshalJSON() ([]byte, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	refs := make([]int, 0, len(g.tracks))
+	for ref := range g.tracks {
+		refs = append(refs, ref)
+	}
+	slices.Sort(refs)
+
+	exported := idGraphJSON{Tracks: make([]idGraphTrack, 0, len(refs))}
+	for _, ref := range refs {
+		exported.Tracks = append(exported.Tracks, *g.tracks[ref])
+	}
+
+	return json.Marshal(exported)
+}
+
+// UnmarshalJSON imports an exported graph, merging its tracks into the ones already in g.
+func (g *IDGraph) UnmarshalJSON(data []byte) error {
+	var imported idGraphJSON
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return fmt.Errorf("json unmarshal: %w", err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, track := range imported.Tracks {
+		// hand-written graphs may only carry TrackIDs.ISRC
+		if track.ISRC != "" {
+			track.ISRCs = appendMissing([]string{track.ISRC}, track.ISRCs)
+		}
+		g.add(track)
+	}
+
+	return nil
+}
+
+// trackIDKeys returns the keys of all the IDs of a track, on every platform.
+func trackIDKeys(trackIDs TrackIDs) []idGraphKey {
+	var keys []idGraphKey
+	addKeys := func(platform TrackPlatform, ids []string) {
+		for _, id := range ids {
+			keys = append(keys, idGraphKey{platform, id})
+		}
+	}
+
+	if trackIDs.ISRC != "" {
+		addKeys(TrackPlatformISRC, []string{trackIDs.ISRC})
+	}
+	addKeys(TrackPlatformChartmetric, intsToStrings(trackIDs.ChartmetricIDs))
+	addKeys(TrackPlatformSpotify, trackIDs.SpotifyIDs)
+	addKeys(TrackPlatformITunes, trackIDs.ITunesIDs)
+	addKeys(TrackPlatformDeezer, trackIDs.DeezerIDs)
+	addKeys(TrackPlatformAmazon, trackIDs.AmazonIDs)
+	addKeys(TrackPlatformYouTube, trackIDs.YouTubeIDs)
+	addKeys(TrackPlatformSoundCloud, trackIDs.SoundCloudIDs)
+	addKeys(TrackPlatformShazam, trackIDs.ShazamIDs)
+	addKeys(TrackPlatformTikTok, trackIDs.TikTokIDs)
+	addKeys(TrackPlatformBeatport, intsToStrings(trackIDs.BeatportIDs))
+	addKeys(TrackPlatformQQ, trackIDs.QQIDs)
+	addKeys(TrackPlatformGenius, intsToStrings(trackIDs.GeniusIDs))
+
+	return keys
+}
+
+// mergeTrackIDs adds to dst the IDs of src it doesn't have yet.
+// The ISRC of dst is kept, unless it is empty (see idGraphTrack for keeping both).
+func mergeTrackIDs(dst *TrackIDs, src TrackIDs) {
+	if dst.ISRC == "" {
+		dst.ISRC = src.ISRC
+	}
+	dst.ChartmetricIDs = appendMissing(dst.ChartmetricIDs, src.ChartmetricIDs)
+	dst.SpotifyIDs = appendMissing(dst.SpotifyIDs, src.SpotifyIDs)
+	dst.ITunesIDs = appendMissing(dst.ITunesIDs, src.ITunesIDs)
+	dst.DeezerIDs = appendMissing(dst.DeezerIDs, src.DeezerIDs)
+	dst.AmazonIDs = appendMissing(dst.AmazonIDs, src.AmazonIDs)
+	dst.YouTubeIDs = appendMissing(dst.YouTubeIDs, src.YouTubeIDs)
+	dst.SoundCloudIDs = appendMissing(dst.SoundCloudIDs, src.SoundCloudIDs)
+	dst.ShazamIDs = appendMissing(dst.ShazamIDs, src.ShazamIDs)
+	dst.TikTokIDs = appendMissing(dst.TikTokIDs, src.TikTokIDs)
+	dst.BeatportIDs = appendMissing(dst.BeatportIDs, src.BeatportIDs)
+	dst.QQIDs = appendMissing(dst.QQIDs, src.QQIDs)
+	dst.GeniusIDs = appendMissing(dst.GeniusIDs, src.GeniusIDs)
+}
+
+func cloneTrackIDs(trackIDs TrackIDs) TrackIDs {
+	var clone TrackIDs
+	mergeTrackIDs(&clone, trackIDs)
+	return clone
+}
+
+func appendMissing[T comparable](dst, src []T) []T {
+	for _, v := range src {
+		if !slices.Contains(dst, v) {
+			dst = append(dst, v)
+		}
+	}
+
+	return dst
+}
+
+func intsToStrings(ints []int) []string {
+	strs := make([]string, len(ints))
+	for i, v := range ints {
+		strs[i] = strconv.Itoa(v)
+	}
+
+	return strs
+}
@@ -0,0 +1,112 @@
+package chartmetric_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_ResolveTrackIDs(t *testing.T) {
+	var requests atomic.Int32
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /track/isrc/{id}/get-ids": func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if r.PathValue("id") == "USWB12104213" {
+				w.Write([]byte(testdata.TrackIDsResponse))
+			} else {
+				w.Write([]byte(`{"obj":[]}`))
+			}
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL), chartmetric.WithRateLimitPerSec(100))
+	graph := chartmetric.NewIDGraph()
+
+	results := client.ResolveTrackIDs(context.Background(), chartmetric.TrackPlatformISRC, []string{"USWB12104213", "XX0000000000", "USWB12104213"}, &chartmetric.ResolveTrackIDsOptions{
+		Concurrency: 2,
+		Graph:       graph,
+	})
+	require.Len(t, results, 3)
+	assert.Equal(t, "USWB12104213", results[0].ID)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []int{49822014}, results[0].IDs.ChartmetricIDs)
+	assert.Equal(t, "XX0000000000", results[1].ID)
+	assert.Error(t, results[1].Err)
+	assert.Nil(t, results[1].IDs)
+	require.NoError(t, results[2].Err)
+	assert.Equal(t, results[0].IDs, results[2].IDs)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// any ID of a resolved track is now answered by the graph
+	results = client.ResolveTrackIDs(context.Background(), chartmetric.TrackPlatformSpotify, []string{"3ebXMykcMXOcLeJ9xZ17XH"}, &chartmetric.ResolveTrackIDsOptions{
+		Graph: graph,
+	})
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, "USWB12104213", results[0].IDs.ISRC)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func Test_IDGraph(t *testing.T) {
+	graph := chartmetric.NewIDGraph()
+	graph.Add(chartmetric.TrackIDs{ISRC: "USWB12104213", SpotifyIDs: []string{"sp1"}})
+	graph.Add(chartmetric.TrackIDs{ChartmetricIDs: []int{49822014}, DeezerIDs: []string{"dz1"}})
+	assert.Equal(t, 2, graph.Len())
+
+	// a track sharing IDs with both joins them into one
+	graph.Add(chartmetric.TrackIDs{SpotifyIDs: []string{"sp1"}, DeezerIDs: []string{"dz1"}, BeatportIDs: []int{777}})
+	assert.Equal(t, 1, graph.Len())
+
+	trackIDs, ok := graph.Lookup(chartmetric.TrackPlatformBeatport, "777")
+	require.True(t, ok)
+	assert.Equal(t, "USWB12104213", trackIDs.ISRC)
+	assert.Equal(t, []int{49822014}, trackIDs.ChartmetricIDs)
+	assert.Equal(t, []string{"sp1"}, trackIDs.SpotifyIDs)
+
+	_, ok = graph.Lookup(chartmetric.TrackPlatformSpotify, "unknown")
+	assert.False(t, ok)
+
+	data, err := json.Marshal(graph)
+	require.NoError(t, err)
+
+	imported := chartmetric.NewIDGraph()
+	require.NoError(t, json.Unmarshal(data, imported))
+	assert.Equal(t, 1, imported.Len())
+	importedIDs, ok := imported.Lookup(chartmetric.TrackPlatformChartmetric, "49822014")
+	require.True(t, ok)
+	assert.Equal(t, trackIDs, importedIDs)
+
+	t.Run("merge tracks with different ISRCs", func(t *testing.T) {
+		graph := chartmetric.NewIDGraph()
+		graph.Add(chartmetric.TrackIDs{ISRC: "USWB12104213", SpotifyIDs: []string{"sp1"}})
+		graph.Add(chartmetric.TrackIDs{ISRC: "USWB12104214", SpotifyIDs: []string{"sp2"}})
+		graph.Add(chartmetric.TrackIDs{SpotifyIDs: []string{"sp1", "sp2"}})
+		assert.Equal(t, 1, graph.Len())
+
+		for _, isrc := range []string{"USWB12104213", "USWB12104214"} {
+			trackIDs, ok := graph.Lookup(chartmetric.TrackPlatformISRC, isrc)
+			require.True(t, ok, isrc)
+			assert.Equal(t, []string{"sp1", "sp2"}, trackIDs.SpotifyIDs)
+		}
+
+		data, err := json.Marshal(graph)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"isrcs":["USWB12104213","USWB12104214"]`)
+
+		imported := chartmetric.NewIDGraph()
+		require.NoError(t, json.Unmarshal(data, imported))
+		_, ok := imported.Lookup(chartmetric.TrackPlatformISRC, "USWB12104214")
+		assert.True(t, ok)
+	})
+}
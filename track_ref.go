@@ -0,0 +1,232 @@
+package chartmetric
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	isrcRegexp                 = regexp.MustCompile(`^[A-Z]{2}[0-9A-Z]{3}[0-9]{7}$`)
+	spotifyTrackPathRegexp     = regexp.MustCompile(`^(?:/intl-[a-z-]+)?/track/([0-9A-Za-z]{22})/?$`)
+	deezerTrackPathRegexp      = regexp.MustCompile(`^(?:/[a-z]{2})?/track/(\d+)/?$`)
+	appleMusicSongPathRegexp   = regexp.MustCompile(`^(?:/[a-z]{2})?/song/(?:[^/]+/)?(?:id)?(\d+)/?$`)
+	appleMusicAlbumPathRegexp  = regexp.MustCompile(`^(?:/[a-z]{2})?/album/`)
+	amazonTrackPathRegexp      = regexp.MustCompile(`^/tracks/([0-9A-Z]{10})/?$`)
+	amazonProductPathRegexp    = regexp.MustCompile(`^(?:/[^/]+)?/dp/([0-9A-Z]{10})/?$`)
+	amazonASINRegexp           = regexp.MustCompile(`^[0-9A-Z]{10}$`)
+	youTubeVideoIDRegexp       = regexp.MustCompile(`^[0-9A-Za-z_-]{11}$`)
+	youTubeVideoPathRegexp     = regexp.MustCompile(`^/(?:shorts|embed|live|v)/([0-9A-Za-z_-]{11})/?$`)
+	youTubeShortPathRegexp     = regexp.MustCompile(`^/([0-9A-Za-z_-]{11})/?$`)
+	soundCloudTrackPathRegexp  = regexp.MustCompile(`^/([0-9A-Za-z_-]+/[0-9A-Za-z_-]+)/?$`)
+	soundCloudAPITrackRegexp   = regexp.MustCompile(`^/tracks/(\d+)/?$`)
+	tikTokMusicPathRegexp      = regexp.MustCompile(`^/music/(?:[^/]*-)?(\d+)/?$`)
+	shazamTrackPathRegexp      = regexp.MustCompile(`^(?:/[a-z]{2}(?:-[a-z]{2})?)?/(?:track|song)/(\d+)(?:/[^/]*)?/?$`)
+	beatportTrackPathRegexp    = regexp.MustCompile(`^(?:/[a-z]{2})?/track/[^/]+/(\d+)/?$`)
+	geniusSongPathRegexp       = regexp.MustCompile(`^/songs/(\d+)/?$`)
+	qqSongPathRegexp           = regexp.MustCompile(`^/n/(?:ryqq/songDetail/([0-9A-Za-z]+)|yqq/song/([0-9A-Za-z]+)\.html)/?$`)
+	chartmetricTrackPathRegexp = regexp.MustCompile(`^/track/(\d+)(?:/[^/]*)?/?$`)
+)
+
+// ErrSoundCloudPermalink is returned by ParseTrackRef for SoundCloud page URLs (soundcloud.com/<user>/<track>),
+// which have no track ID. Their permalink can be extracted with ParseSoundCloudPermalink, then resolved
+// to a SoundCloud track ID (e.g. with SoundCloud's resolve API).
+var ErrSoundCloudPermalink = errors.New("soundcloud permalink has no track id")
+
+// reservedSoundCloudPaths are first path segments of SoundCloud URLs that are not users.
+var reservedSoundCloudPaths = map[string]bool{
+	"discover": true, "search": true, "stream": true, "charts": true, "you": true, "upload": true, "pages": true,
+}
+
+// ParseTrackRef parses a reference to a track, as pasted by users, into its platform and the track's ID
+// on that platform, ready to be passed to GetTrackIDs. It accepts:
+//   - track URLs of Spotify, Apple Music/iTunes (song links, or album links with ?i=), Deezer, Amazon Music,
+//     YouTube (watch, shorts and youtu.be links), SoundCloud API and player URLs, TikTok music pages, Shazam,
+//     Beatport, Genius, QQ Music and Chartmetric
+//   - Spotify URIs (spotify:track:<id>)
+//   - raw ISRCs, with or without dashes
+//
+// SoundCloud page URLs (soundcloud.com/<user>/<track>) have no track ID: an error wrapping
+// ErrSoundCloudPermalink is returned for them.
+func ParseTrackRef(s string) (TrackPlatform, string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", "", fmt.Errorf("empty track reference")
+	}
+
+	if id, ok := strings.CutPrefix(s, "spotify:track:"); ok {
+		if !spotifyIDRegexp.MatchString(id) {
+			return "", "", fmt.Errorf("invalid spotify track id %q", id)
+		}
+		return TrackPlatformSpotify, id, nil
+	}
+	if isrc := strings.ToUpper(strings.ReplaceAll(s, "-", "")); isrcRegexp.MatchString(isrc) {
+		return TrackPlatformISRC, isrc, nil
+	}
+
+	rawURL := s
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("parse url: %w", err)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+
+	var platform TrackPlatform
+	var match []string
+	switch host {
+	case "open.spotify.com", "play.spotify.com":
+		platform, match = TrackPlatformSpotify, spotifyTrackPathRegexp.FindStringSubmatch(u.Path)
+	case "music.apple.com", "itunes.apple.com", "geo.music.apple.com":
+		platform = TrackPlatformITunes
+		// album links point at a track with the i query param
+		if id := u.Query().Get("i"); appleMusicAlbumPathRegexp.MatchString(u.Path) && id != "" {
+			return platform, id, nil
+		}
+		match = appleMusicSongPathRegexp.FindStringSubmatch(u.Path)
+	case "deezer.com":
+		platform, match = TrackPlatformDeezer, deezerTrackPathRegexp.FindStringSubmatch(u.Path)
+	case "music.amazon.com", "amazon.com":
+		platform = TrackPlatformAmazon
+		if id := u.Query().Get("trackAsin"); amazonASINRegexp.MatchString(id) {
+			return platform, id, nil
+		}
+		if match = amazonTrackPathRegexp.FindStringSubmatch(u.Path); match == nil {
+			match = amazonProductPathRegexp.FindStringSubmatch(u.Path)
+		}
+	case "youtube.com", "music.youtube.com":
+		platform = TrackPlatformYouTube
+		if id := u.Query().Get("v"); u.Path == "/watch" && youTubeVideoIDRegexp.MatchString(id) {
+			return platform, id, nil
+		}
+		match = youTubeVideoPathRegexp.FindStringSubmatch(u.Path)
+	case "youtu.be":
+		platform, match = TrackPlatformYouTube, youTubeShortPathRegexp.FindStringSubmatch(u.Path)
+	case "api.soundcloud.com":
+		platform, match = TrackPlatformSoundCloud, soundCloudAPITrackRegexp.FindStringSubmatch(u.Path)
+	case "w.soundcloud.com":
+		// player URLs embed the API URL of the track in the url query param
+		platform = TrackPlatformSoundCloud
+		if apiURL, err := url.Parse(u.Query().Get("url")); err == nil && strings.EqualFold(apiURL.Hostname(), "api.soundcloud.com") {
+			match = soundCloudAPITrackRegexp.FindStringSubmatch(apiURL.Path)
+		}
+	case "soundcloud.com":
+		if permalink, ok := soundCloudPermalink(u.Path); ok {
+			return "", "", fmt.Errorf("%w: %q", ErrSoundCloudPermalink, permalink)
+		}
+		return "", "", fmt.Errorf("unsupported %s track url path %q", TrackPlatformSoundCloud, u.Path)
+	case "tiktok.com":
+		platform, match = TrackPlatformTikTok, tikTokMusicPathRegexp.FindStringSubmatch(u.Path)
+	case "shazam.com":
+		platform, match = TrackPlatformShazam, shazamTrackPathRegexp.FindStringSubmatch(u.Path)
+	case "beatport.com":
+		platform, match = TrackPlatformBeatport, beatportTrackPathRegexp.FindStringSubmatch(u.Path)
+	case "genius.com":
+		platform, match = TrackPlatformGenius, geniusSongPathRegexp.FindStringSubmatch(u.Path)
+	case "y.qq.com":
+		platform, match = TrackPlatformQQ, qqSongPathRegexp.FindStringSubmatch(u.Path)
+		// the ID is in one of the two alternative groups
+		if match != nil && match[1] == "" {
+			match[1] = match[2]
+		}
+	case "app.chartmetric.com", "chartmetric.com":
+		platform, match = TrackPlatformChartmetric, chartmetricTrackPathRegexp.FindStringSubmatch(u.Path)
+	default:
+		return "", "", fmt.Errorf("unsupported track reference %q", s)
+	}
+
+	if match == nil {
+		return "", "", fmt.Errorf("unsupported %s track url path %q", platform, u.Path)
+	}
+
+	return platform, match[1], nil
+}
+
+// ParseSoundCloudPermalink parses a SoundCloud track page URL (soundcloud.com/<user>/<track>)
+// into its "<user>/<track>" permalink.
+func ParseSoundCloudPermalink(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	if host != "soundcloud.com" {
+		return "", fmt.Errorf("unsupported host %q", host)
+	}
+
+	permalink, ok := soundCloudPermalink(u.Path)
+	if !ok {
+		return "", fmt.Errorf("unsupported %s track url path %q", TrackPlatformSoundCloud, u.Path)
+	}
+
+	return permalink, nil
+}
+
+func soundCloudPermalink(path string) (string, bool) {
+	match := soundCloudTrackPathRegexp.FindStringSubmatch(path)
+	if match == nil {
+		return "", false
+	}
+	user, track, _ := strings.Cut(match[1], "/")
+	if reservedSoundCloudPaths[strings.ToLower(user)] || track == "sets" || track == "tracks" {
+		return "", false
+	}
+
+	return match[1], true
+}
+
+// FormatTrackURL returns the canonical URL of a track on a platform, given its ID on that platform
+// (as returned by ParseTrackRef or found in TrackIDs). ISRCs have no URL.
+// SoundCloud track IDs have no page URL, so the URL of the SoundCloud player is returned for them.
+func FormatTrackURL(platform TrackPlatform, id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("empty %s track ID", platform)
+	}
+
+	switch platform {
+	case TrackPlatformAmazon:
+		return "https://music.amazon.com/tracks/" + url.PathEscape(id), nil
+	case TrackPlatformBeatport:
+		return "https://www.beatport.com/track/-/" + url.PathEscape(id), nil
+	case TrackPlatformDeezer:
+		return "https://www.deezer.com/track/" + url.PathEscape(id), nil
+	case TrackPlatformGenius:
+		return "https://genius.com/songs/" + url.PathEscape(id), nil
+	case TrackPlatformITunes:
+		return "https://music.apple.com/song/" + url.PathEscape(id), nil
+	case TrackPlatformShazam:
+		return "https://www.shazam.com/track/" + url.PathEscape(id), nil
+	case TrackPlatformSoundCloud:
+		if strings.Contains(id, "/") {
+			return "", fmt.Errorf("soundcloud permalink %q is not a track id", id)
+		}
+		// a track ID has no page URL, only a player URL
+		return "https://w.soundcloud.com/player/?url=" + url.QueryEscape("https://api.soundcloud.com/tracks/"+id), nil
+	case TrackPlatformSpotify:
+		return "https://open.spotify.com/track/" + url.PathEscape(id), nil
+	case TrackPlatformTikTok:
+		return "https://www.tiktok.com/music/-" + url.PathEscape(id), nil
+	case TrackPlatformQQ:
+		return "https://y.qq.com/n/ryqq/songDetail/" + url.PathEscape(id), nil
+	case TrackPlatformYouTube:
+		return "https://www.youtube.com/watch?v=" + url.QueryEscape(id), nil
+	case TrackPlatformChartmetric:
+		return "https://app.chartmetric.com/track/" + url.PathEscape(id), nil
+	case TrackPlatformISRC:
+		return "", fmt.Errorf("isrc %q has no url", id)
+	default:
+		return "", fmt.Errorf("unsupported track platform %q", platform)
+	}
+}
@@ -0,0 +1,133 @@
+package chartmetric_test
+
+import (
+	"testing"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseTrackRef(t *testing.T) {
+	tests := []struct {
+		ref          string
+		wantPlatform chartmetric.TrackPlatform
+		wantID       string
+		wantErr      bool
+	}{
+		{ref: "https://open.spotify.com/track/3ebXMykcMXOcLeJ9xZ17XH", wantPlatform: chartmetric.TrackPlatformSpotify, wantID: "3ebXMykcMXOcLeJ9xZ17XH"},
+		{ref: "https://open.spotify.com/intl-pt/track/3ebXMykcMXOcLeJ9xZ17XH?si=a1b2c3d4", wantPlatform: chartmetric.TrackPlatformSpotify, wantID: "3ebXMykcMXOcLeJ9xZ17XH"},
+		{ref: "spotify:track:3ebXMykcMXOcLeJ9xZ17XH", wantPlatform: chartmetric.TrackPlatformSpotify, wantID: "3ebXMykcMXOcLeJ9xZ17XH"},
+		{ref: " open.spotify.com/track/3ebXMykcMXOcLeJ9xZ17XH ", wantPlatform: chartmetric.TrackPlatformSpotify, wantID: "3ebXMykcMXOcLeJ9xZ17XH"},
+		{ref: "https://music.apple.com/br/album/envolver/1592396277?i=1592396282", wantPlatform: chartmetric.TrackPlatformITunes, wantID: "1592396282"},
+		{ref: "https://music.apple.com/us/song/envolver/1592396282", wantPlatform: chartmetric.TrackPlatformITunes, wantID: "1592396282"},
+		{ref: "https://itunes.apple.com/us/album/envolver/id1592396277?i=1592396282&uo=4", wantPlatform: chartmetric.TrackPlatformITunes, wantID: "1592396282"},
+		{ref: "https://www.deezer.com/en/track/1549325802", wantPlatform: chartmetric.TrackPlatformDeezer, wantID: "1549325802"},
+		{ref: "deezer.com/track/1549325802", wantPlatform: chartmetric.TrackPlatformDeezer, wantID: "1549325802"},
+		{ref: "https://music.amazon.com/albums/B09LHWQ8ZL?trackAsin=B09LHXJ7JH", wantPlatform: chartmetric.TrackPlatformAmazon, wantID: "B09LHXJ7JH"},
+		{ref: "https://music.amazon.com/tracks/B09LHXJ7JH", wantPlatform: chartmetric.TrackPlatformAmazon, wantID: "B09LHXJ7JH"},
+		{ref: "https://www.amazon.com/Envolver/dp/B09LHXJ7JH", wantPlatform: chartmetric.TrackPlatformAmazon, wantID: "B09LHXJ7JH"},
+		{ref: "https://www.youtube.com/watch?v=fHEDy_Ryxx4&list=RDfHEDy_Ryxx4", wantPlatform: chartmetric.TrackPlatformYouTube, wantID: "fHEDy_Ryxx4"},
+		{ref: "https://m.youtube.com/watch?v=fHEDy_Ryxx4", wantPlatform: chartmetric.TrackPlatformYouTube, wantID: "fHEDy_Ryxx4"},
+		{ref: "https://music.youtube.com/watch?v=fHEDy_Ryxx4&feature=share", wantPlatform: chartmetric.TrackPlatformYouTube, wantID: "fHEDy_Ryxx4"},
+		{ref: "https://youtu.be/fHEDy_Ryxx4?si=abc", wantPlatform: chartmetric.TrackPlatformYouTube, wantID: "fHEDy_Ryxx4"},
+		{ref: "https://www.youtube.com/shorts/fHEDy_Ryxx4", wantPlatform: chartmetric.TrackPlatformYouTube, wantID: "fHEDy_Ryxx4"},
+		{ref: "https://api.soundcloud.com/tracks/1163217586", wantPlatform: chartmetric.TrackPlatformSoundCloud, wantID: "1163217586"},
+		{ref: "https://w.soundcloud.com/player/?url=https%3A//api.soundcloud.com/tracks/1163217586&color=%23ff5500", wantPlatform: chartmetric.TrackPlatformSoundCloud, wantID: "1163217586"},
+		{ref: "https://www.tiktok.com/music/Envolver-7030066223532019713", wantPlatform: chartmetric.TrackPlatformTikTok, wantID: "7030066223532019713"},
+		{ref: "https://www.tiktok.com/music/7030066223532019713?lang=en", wantPlatform: chartmetric.TrackPlatformTikTok, wantID: "7030066223532019713"},
+		{ref: "https://www.shazam.com/track/597464325/envolver", wantPlatform: chartmetric.TrackPlatformShazam, wantID: "597464325"},
+		{ref: "https://www.shazam.com/pt-br/song/597464325/envolver", wantPlatform: chartmetric.TrackPlatformShazam, wantID: "597464325"},
+		{ref: "https://www.beatport.com/track/envolver/15822031", wantPlatform: chartmetric.TrackPlatformBeatport, wantID: "15822031"},
+		{ref: "https://genius.com/songs/7515493", wantPlatform: chartmetric.TrackPlatformGenius, wantID: "7515493"},
+		{ref: "https://y.qq.com/n/ryqq/songDetail/0039MnYb0qxYhV", wantPlatform: chartmetric.TrackPlatformQQ, wantID: "0039MnYb0qxYhV"},
+		{ref: "https://y.qq.com/n/yqq/song/0039MnYb0qxYhV.html", wantPlatform: chartmetric.TrackPlatformQQ, wantID: "0039MnYb0qxYhV"},
+		{ref: "https://app.chartmetric.com/track/49822014", wantPlatform: chartmetric.TrackPlatformChartmetric, wantID: "49822014"},
+		{ref: "USWB12104213", wantPlatform: chartmetric.TrackPlatformISRC, wantID: "USWB12104213"},
+		{ref: "us-wb1-21-04213", wantPlatform: chartmetric.TrackPlatformISRC, wantID: "USWB12104213"},
+		{ref: "", wantErr: true},
+		{ref: "spotify:track:3ebXMykcMXOcLeJ9xZ17XH?si=1", wantErr: true},
+		{ref: "spotify:track:", wantErr: true},
+		{ref: "https://soundcloud.com/anitta-official/envolver", wantErr: true},
+		{ref: "https://open.spotify.com/artist/7FNnA9vBm6EKceENgCGRMb", wantErr: true},
+		{ref: "https://music.apple.com/br/album/versions-of-me/1612491787", wantErr: true},
+		{ref: "https://www.youtube.com/channel/UCeuT-2XIGyEyl7CqmKfk3Og", wantErr: true},
+		{ref: "https://soundcloud.com/anitta-official", wantErr: true},
+		{ref: "https://soundcloud.com/anitta-official/sets", wantErr: true},
+		{ref: "https://api.soundcloud.com/users/12345", wantErr: true},
+		{ref: "https://w.soundcloud.com/player/?url=https%3A//example.com/tracks/1163217586", wantErr: true},
+		{ref: "https://genius.com/Anitta-envolver-lyrics", wantErr: true},
+		{ref: "https://www.tiktok.com/@anitta", wantErr: true},
+		{ref: "https://example.com/track/1", wantErr: true},
+		{ref: "USWB1210421", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			platform, id, err := chartmetric.ParseTrackRef(tt.ref)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPlatform, platform)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
+
+func Test_FormatTrackURL(t *testing.T) {
+	tests := []struct {
+		platform chartmetric.TrackPlatform
+		id       string
+		wantURL  string
+	}{
+		{platform: chartmetric.TrackPlatformAmazon, id: "B09LHXJ7JH", wantURL: "https://music.amazon.com/tracks/B09LHXJ7JH"},
+		{platform: chartmetric.TrackPlatformBeatport, id: "15822031", wantURL: "https://www.beatport.com/track/-/15822031"},
+		{platform: chartmetric.TrackPlatformDeezer, id: "1549325802", wantURL: "https://www.deezer.com/track/1549325802"},
+		{platform: chartmetric.TrackPlatformGenius, id: "7515493", wantURL: "https://genius.com/songs/7515493"},
+		{platform: chartmetric.TrackPlatformITunes, id: "1592396282", wantURL: "https://music.apple.com/song/1592396282"},
+		{platform: chartmetric.TrackPlatformShazam, id: "597464325", wantURL: "https://www.shazam.com/track/597464325"},
+		{platform: chartmetric.TrackPlatformSoundCloud, id: "1163217586", wantURL: "https://w.soundcloud.com/player/?url=https%3A%2F%2Fapi.soundcloud.com%2Ftracks%2F1163217586"},
+		{platform: chartmetric.TrackPlatformSpotify, id: "3ebXMykcMXOcLeJ9xZ17XH", wantURL: "https://open.spotify.com/track/3ebXMykcMXOcLeJ9xZ17XH"},
+		{platform: chartmetric.TrackPlatformTikTok, id: "7030066223532019713", wantURL: "https://www.tiktok.com/music/-7030066223532019713"},
+		{platform: chartmetric.TrackPlatformQQ, id: "0039MnYb0qxYhV", wantURL: "https://y.qq.com/n/ryqq/songDetail/0039MnYb0qxYhV"},
+		{platform: chartmetric.TrackPlatformYouTube, id: "fHEDy_Ryxx4", wantURL: "https://www.youtube.com/watch?v=fHEDy_Ryxx4"},
+		{platform: chartmetric.TrackPlatformChartmetric, id: "49822014", wantURL: "https://app.chartmetric.com/track/49822014"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.platform), func(t *testing.T) {
+			u, err := chartmetric.FormatTrackURL(tt.platform, tt.id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURL, u)
+
+			// formatted URLs parse back to the same reference
+			platform, id, err := chartmetric.ParseTrackRef(u)
+			require.NoError(t, err)
+			assert.Equal(t, tt.platform, platform)
+			assert.Equal(t, tt.id, id)
+		})
+	}
+
+	_, err := chartmetric.FormatTrackURL(chartmetric.TrackPlatformISRC, "USWB12104213")
+	assert.Error(t, err)
+	_, err = chartmetric.FormatTrackURL(chartmetric.TrackPlatformSpotify, "")
+	assert.Error(t, err)
+	_, err = chartmetric.FormatTrackURL(chartmetric.TrackPlatformSoundCloud, "anitta-official/envolver")
+	assert.Error(t, err)
+}
+
+func Test_ParseSoundCloudPermalink(t *testing.T) {
+	_, _, err := chartmetric.ParseTrackRef("https://soundcloud.com/anitta-official/envolver?si=abc")
+	assert.ErrorIs(t, err, chartmetric.ErrSoundCloudPermalink)
+
+	permalink, err := chartmetric.ParseSoundCloudPermalink("https://soundcloud.com/anitta-official/envolver?si=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "anitta-official/envolver", permalink)
+
+	_, err = chartmetric.ParseSoundCloudPermalink("https://soundcloud.com/anitta-official/sets")
+	assert.Error(t, err)
+	_, err = chartmetric.ParseSoundCloudPermalink("https://api.soundcloud.com/tracks/1163217586")
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package chartmetric_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/musicx-fm/chartmetric-go-client"
+	"github.com/musicx-fm/chartmetric-go-client/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_GetTrack(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /track/49822014": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TrackResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	track, err := client.GetTrack(context.Background(), 49822014)
+	require.NoError(t, err)
+	assert.Equal(t, "Envolver", track.Name)
+	assert.Equal(t, "USWB12104213", track.ISRC)
+	assert.Equal(t, 193266*time.Millisecond, track.Duration())
+	assert.False(t, track.Explicit)
+	assert.Equal(t, "2021-11-11", track.ReleaseDate.Format(chartmetric.DateFormat))
+	require.Len(t, track.Albums, 1)
+	assert.Equal(t, "093624871011", track.Albums[0].UPC)
+	assert.Equal(t, []chartmetric.TrackArtist{{ID: 3380, Name: "Anitta"}}, track.Artists)
+	assert.Len(t, track.Genres, 2)
+	require.NotNil(t, track.AudioFeatures)
+	assert.Equal(t, 91.97, track.AudioFeatures.Tempo)
+	assert.Equal(t, 10, track.AudioFeatures.Key)
+	assert.Equal(t, 88.6, track.ChartmetricTrackScore)
+	require.Len(t, track.Songwriters, 2)
+	assert.Equal(t, 3380, track.Songwriters[0].ChartmetricArtistID)
+	assert.Equal(t, chartmetric.TrackCredit{Name: "Ryan Tedder"}, track.Songwriters[1])
+	assert.Equal(t, []chartmetric.TrackCredit{{Name: "Ryan Tedder"}}, track.Producers)
+}
+
+func Test_Client_GetTrackStats(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /track/49822014/spotify/stats": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2024-03-01", r.URL.Query().Get("since"))
+			assert.Equal(t, "streams", r.URL.Query().Get("type"))
+			assert.Equal(t, "cumulative", r.URL.Query().Get("mode"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TrackStatsSpotifyStreamsResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	series, err := client.GetTrackStats(context.Background(), 49822014, chartmetric.TrackStatPlatformSpotify, &chartmetric.GetTrackStatsParams{
+		Since: chartmetric.Opt(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		Type:  chartmetric.Opt(chartmetric.TrackStatTypeStreams),
+		Mode:  chartmetric.Opt(chartmetric.TrackStatModeCumulative),
+	})
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	assert.Equal(t, chartmetric.TrackStatPlatformSpotify, series[0].Domain)
+	assert.Equal(t, "3ebXMykcMXOcLeJ9xZ17XH", series[0].ID)
+	require.Len(t, series[0].Data, 3)
+	assert.Equal(t, "2024-03-02", series[0].Data[1].Timestamp.Format(chartmetric.DateFormat))
+	assert.Equal(t, 412350000.0, series[0].Data[1].Value)
+	assert.Equal(t, 350000.0, series[0].Data[1].Diff)
+	assert.True(t, series[0].Data[2].Interpolated)
+
+	// the second Spotify ID of the track has its own series over the same dates
+	assert.Equal(t, "1QtWnPSahAy3H2A1qtiGE3", series[1].ID)
+	require.Len(t, series[1].Data, 2)
+	assert.Equal(t, series[0].Data[1].Timestamp, series[1].Data[1].Timestamp)
+	assert.Equal(t, 25140000.0, series[1].Data[1].Value)
+
+	total := chartmetric.SumTrackStatSeries(series)
+	require.Len(t, total, 3)
+	assert.Equal(t, "2024-03-02", total[1].Timestamp.Format(chartmetric.DateFormat))
+	assert.Equal(t, 437490000.0, total[1].Value)
+	assert.Equal(t, 390000.0, total[1].Diff)
+	assert.Equal(t, 412690000.0, total[2].Value)
+	assert.True(t, total[2].Interpolated)
+}
+
+func Test_Client_GetTrackCharts(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /track/49822014/tiktok_top_tracks/charts": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2022-01-01", r.URL.Query().Get("since"))
+			assert.False(t, r.URL.Query().Has("until"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TrackChartsTikTokResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	entries, err := client.GetTrackCharts(
+		context.Background(),
+		49822014,
+		chartmetric.TrackChartTypeTikTokTopTracks,
+		chartmetric.GetTrackChartsParams{Since: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "US", entries[0].CountryCode)
+	assert.Equal(t, 4, entries[0].Rank)
+	assert.Equal(t, 11, entries[0].PreRank)
+	assert.Equal(t, 184000, entries[0].Posts)
+	require.Len(t, entries[0].RankStats, 2)
+	assert.Equal(t, 97000, entries[0].RankStats[0].Posts)
+}
+
+func Test_Client_GetTrackPlaylists(t *testing.T) {
+	ts := chartmetricTestServer(map[string]http.HandlerFunc{
+		"POST /token": tokenHandler,
+		"GET /track/49822014/spotify/current/playlists": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.URL.Query().Get("editorial"))
+			assert.Equal(t, "false", r.URL.Query().Get("indie"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(testdata.TrackPlaylistsSpotifyCurrentResponse))
+		},
+	})
+	defer ts.Close()
+
+	client := chartmetric.NewClient("test-refresh-token", chartmetric.WithBaseURL(ts.URL))
+
+	placements, err := client.GetTrackPlaylists(context.Background(), 49822014, chartmetric.PlaylistPlatformSpotify, chartmetric.PlaylistStatusCurrent, &chartmetric.GetPlaylistPlacementsParams{
+		Editorial: chartmetric.Opt(true),
+		Indie:     chartmetric.Opt(false),
+	})
+	require.NoError(t, err)
+	require.Len(t, placements, 2)
+	assert.Equal(t, "New Music Friday", placements[0].Playlist.Name)
+	assert.Equal(t, 4, placements[0].Playlist.Position)
+	assert.Equal(t, 3900000, placements[0].Playlist.Followers)
+	assert.Equal(t, time.Date(2024, 2, 23, 0, 0, 0, 0, time.UTC), placements[1].Playlist.AddedAt)
+	assert.True(t, placements[1].Playlist.RemovedAt.IsZero())
+}